@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/iskanye/avito-tech-internship/internal/config"
 )
@@ -27,21 +28,33 @@ func main() {
 	cfg := config.MustLoadPath(configPath)
 	cfg.LoadEnv()
 
-	uri := fmt.Sprintf("%s:%s@%s:%d/%s",
-		cfg.Postgres.User,
-		cfg.Postgres.Password,
-		cfg.Postgres.Host,
-		cfg.Postgres.Port,
-		cfg.Postgres.DBName,
-	)
-
 	if migrationsPath == "" {
 		panic("migrations-path is required")
 	}
 
+	var databaseURL string
+	switch cfg.Storage.Driver {
+	case "postgres":
+		uri := fmt.Sprintf("%s:%s@%s:%d/%s",
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.DBName,
+		)
+		databaseURL = fmt.Sprintf("pgx5://%s?x-migrations-table=%s&sslmode=disable", uri, migrationsTable)
+	case "sqlite":
+		databaseURL = fmt.Sprintf("sqlite://%s?x-migrations-table=%s", cfg.SQLite.Path, migrationsTable)
+	case "memory":
+		fmt.Println("memory storage does not need migrations")
+		return
+	default:
+		panic("unknown storage: " + cfg.Storage.Driver)
+	}
+
 	m, err := migrate.New(
 		"file://"+migrationsPath,
-		fmt.Sprintf("pgx5://%s?x-migrations-table=%s&sslmode=disable", uri, migrationsTable),
+		databaseURL,
 	)
 	if err != nil {
 		panic(err)
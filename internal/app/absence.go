@@ -0,0 +1,30 @@
+package app
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Периодически переназначает открытые ревью пользователей,
+// у которых начался период отсутствия
+func (a App) runAbsenceReassigner(interval time.Duration) {
+	const op = "app.runAbsenceReassigner"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			log.Info("Absence reassigner stopped")
+			return
+		case <-ticker.C:
+			// Ошибка уже залогирована сервисом, попробуем на следующем тике
+			_, _ = a.a.ReassignAbsentUsers(a.ctx)
+		}
+	}
+}
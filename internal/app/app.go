@@ -1,24 +1,43 @@
 package app
 
 import (
+	"context"
 	"log/slog"
 	"net"
+	"net/http"
 	"strconv"
+	"time"
 
-	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
-	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/gin-gonic/gin"
 	"github.com/iskanye/avito-tech-internship/internal/config"
-	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/metrics"
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/requestctx"
 	"github.com/iskanye/avito-tech-internship/internal/server"
+	"github.com/iskanye/avito-tech-internship/internal/service/auth"
+	"github.com/iskanye/avito-tech-internship/internal/service/integrations"
 	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/iskanye/avito-tech-internship/internal/service/vcssync"
+	"github.com/iskanye/avito-tech-internship/internal/service/webhooks"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type App struct {
 	e   *gin.Engine
-	s   *repositories.Storage
+	s   storage
+	a   *prassignment.PRAssignment
+	d   *webhooks.Dispatcher
+	v   *vcssync.Syncer
 	log *slog.Logger
 	cfg *config.Config
+
+	// Отправка оставшихся спанов при остановке
+	shutdownTracing func(context.Context) error
+
+	// Остановка фоновых задач
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func New(
@@ -26,52 +45,189 @@ func New(
 	log *slog.Logger,
 	cfg *config.Config,
 ) App {
-	storage, err := repositories.New(
-		cfg.Postgres.Host,
-		cfg.Postgres.Port,
-		cfg.Postgres.User,
-		cfg.Postgres.Password,
-		cfg.Postgres.DBName,
-		cfg.Postgres.MaxConns,
-		trmpgx.DefaultCtxGetter,
+	shutdownTracing, err := tracing.Setup(
+		context.Background(),
+		cfg.Tracing.Exporter,
+		cfg.Tracing.OTLPEndpoint,
+		cfg.Tracing.ServiceName,
+		cfg.Tracing.SampleRatio,
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	txManager := manager.Must(trmpgx.NewDefaultFactory(storage.GetPool()))
+	storage, txManager, pool := newStorage(cfg)
+
+	// Спан на каждый запрос. Обработчики получают *gin.Context,
+	// поэтому он должен отдавать значения из контекста запроса
+	engine.ContextWithFallback = true
+	engine.Use(otelgin.Middleware(cfg.Tracing.ServiceName))
+
+	// ID запроса возвращается в ответе и сохраняется в журнале изменений
+	engine.Use(requestctx.Middleware())
+
+	// События пишутся в исходящую очередь подписок и,
+	// если включена синхронизация, в очередь задач для GitHub и GitLab
+	publishers := prassignment.EventPublishers{storage}
+	syncer := newSyncer(log, storage, &cfg.VCSSync)
+	if cfg.VCSSync.Interval > 0 {
+		publishers = append(publishers, syncer)
+	}
+
+	// Метрики отдаются на /metrics
+	appMetrics := metrics.New(pool, storage, cfg.Timeout)
+	engine.Use(appMetrics.Middleware())
+	engine.GET("/metrics", gin.WrapH(appMetrics.Handler()))
 
 	// Это страшно
 	prAssignment := prassignment.New(
 		log,
-		txManager,
-		storage, storage, storage,
+		cfg.Merge.RequiredApprovals,
+		cfg.Reviewers.ReassignParallelism,
+		tracing.NewTxManager(txManager),
+		storage, storage, storage, storage,
 		storage, storage, storage, storage,
 		storage, storage, storage,
 		storage, storage,
+		storage, storage, storage,
+		storage, storage,
+		publishers,
+		appMetrics,
 	)
-	server.Register(engine, prAssignment)
+	webhooksService := webhooks.New(
+		log,
+		storage, storage, storage,
+	)
+	dispatcher := webhooks.NewDispatcher(
+		log,
+		&http.Client{Timeout: cfg.Webhooks.Timeout},
+		storage,
+		cfg.Webhooks.BatchSize,
+		cfg.Webhooks.Parallelism,
+		cfg.Webhooks.Lease,
+		cfg.Webhooks.MaxAttempts,
+		cfg.Webhooks.BackoffBase,
+		cfg.Webhooks.BackoffMax,
+	)
+	// Автора MR, открытого от чужого имени, GitLab передаёт только числовым
+	// ID, и его логин запрашивается через API с токеном синхронизации
+	var gitlabUsers integrations.GitLabUserProvider
+	if cfg.VCSSync.GitLabToken != "" {
+		gitlabUsers = vcssync.NewGitLabClient(
+			&http.Client{Timeout: cfg.VCSSync.Timeout},
+			cfg.VCSSync.GitLabURL,
+			cfg.VCSSync.GitLabToken,
+		)
+	}
+	integrationsService := integrations.New(
+		log,
+		cfg.Integrations.GitHubSecret,
+		cfg.Integrations.GitLabToken,
+		prAssignment,
+		storage, storage,
+		gitlabUsers,
+	)
+	authService := auth.New(
+		log,
+		cfg.Auth.AdminToken,
+		storage, storage, storage,
+		storage, storage,
+	)
+	server.Register(
+		engine,
+		prAssignment,
+		webhooksService,
+		integrationsService,
+		authService,
+		appMetrics.StrictMiddleware(),
+		tracing.StrictMiddleware(),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
 
 	return App{
 		e:   engine,
+		s:   storage,
+		a:   prAssignment,
+		d:   dispatcher,
+		v:   syncer,
 		log: log,
 		cfg: cfg,
+
+		shutdownTracing: shutdownTracing,
+
+		ctx:    ctx,
+		cancel: cancel,
 	}
 }
 
 func (a App) MustRun() {
 	a.log.Info("Service started")
 
+	if a.cfg.Absence.ReassignInterval > 0 {
+		go a.runAbsenceReassigner(a.cfg.Absence.ReassignInterval)
+	}
+	if a.cfg.Stats.RollupInterval > 0 {
+		go a.runMergeTimesRollup(a.cfg.Stats.RollupInterval)
+	}
+	if a.cfg.Webhooks.DispatchInterval > 0 {
+		go a.d.Run(a.ctx, a.cfg.Webhooks.DispatchInterval)
+	}
+	if a.cfg.VCSSync.Interval > 0 {
+		go a.v.Run(a.ctx, a.cfg.VCSSync.Interval)
+	}
+
 	if err := a.e.Run(address(a.cfg.Host, a.cfg.Port)); err != nil {
 		panic(err)
 	}
 }
 
 func (a App) GracefulStop() {
+	a.cancel()
 	a.s.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := a.shutdownTracing(ctx); err != nil {
+		a.log.Error("Failed to shutdown tracing",
+			slog.String("err", err.Error()),
+		)
+	}
+
 	a.log.Info("Gracefully stopped")
 }
 
+// Создаёт синхронизацию с теми системами, для которых задан токен
+func newSyncer(
+	log *slog.Logger,
+	storage storage,
+	cfg *config.VCSSyncConfig,
+) *vcssync.Syncer {
+	client := &http.Client{Timeout: cfg.Timeout}
+
+	clients := map[models.VCSProvider]vcssync.Client{}
+	if cfg.GitHubToken != "" {
+		clients[models.VCS_GITHUB] = vcssync.NewGitHubClient(client, cfg.GitHubURL, cfg.GitHubToken)
+	}
+	if cfg.GitLabToken != "" {
+		clients[models.VCS_GITLAB] = vcssync.NewGitLabClient(client, cfg.GitLabURL, cfg.GitLabToken)
+	}
+
+	return vcssync.New(
+		log,
+		storage,
+		storage,
+		clients,
+		cfg.Timeout,
+		cfg.BatchSize,
+		cfg.Parallelism,
+		cfg.Lease,
+		cfg.MaxAttempts,
+		cfg.BackoffBase,
+		cfg.BackoffMax,
+	)
+}
+
 func address(host string, port int) string {
 	return net.JoinHostPort(host, strconv.Itoa(port))
 }
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Периодически сворачивает время до мерджа за прошедшие дни
+func (a App) runMergeTimesRollup(interval time.Duration) {
+	const op = "app.runMergeTimesRollup"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			log.Info("Merge times rollup stopped")
+			return
+		case <-ticker.C:
+			// Ошибка уже залогирована сервисом, попробуем на следующем тике
+			_ = a.a.RollupMergeTimes(a.ctx)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/exaring/otelpgx"
+	"github.com/iskanye/avito-tech-internship/internal/config"
+	"github.com/iskanye/avito-tech-internship/internal/metrics"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/memory"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/sqlite"
+	"github.com/iskanye/avito-tech-internship/internal/service/auth"
+	"github.com/iskanye/avito-tech-internship/internal/service/integrations"
+	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/iskanye/avito-tech-internship/internal/service/vcssync"
+	"github.com/iskanye/avito-tech-internship/internal/service/webhooks"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Виды хранилищ
+const (
+	STORAGE_POSTGRES = "postgres"
+	STORAGE_SQLITE   = "sqlite"
+	STORAGE_MEMORY   = "memory"
+)
+
+// Хранилище, нужное всем сервисам приложения
+type storage interface {
+	prassignment.UserCreator
+	prassignment.UserModifier
+	prassignment.UserProvider
+	prassignment.UserStatistics
+	prassignment.TeamCreator
+	prassignment.TeamProvider
+	prassignment.TeamModifier
+	prassignment.TeamStatistics
+	prassignment.PRCreator
+	prassignment.PRModifier
+	prassignment.PRProvider
+	prassignment.ReviewersAssigner
+	prassignment.ReviewersModifier
+	prassignment.AbsenceCreator
+	prassignment.AbsenceProvider
+	prassignment.AbsenceModifier
+	prassignment.AuditCreator
+	prassignment.AuditProvider
+	prassignment.EventPublisher
+
+	webhooks.WebhookCreator
+	webhooks.WebhookProvider
+	webhooks.WebhookModifier
+	webhooks.DeliveryQueue
+
+	integrations.VCSLoginProvider
+	integrations.VCSLoginModifier
+
+	auth.TokenCreator
+	auth.TokenProvider
+	auth.TokenModifier
+
+	vcssync.JobQueue
+	vcssync.LoginProvider
+
+	metrics.OpenPullRequestsProvider
+
+	Stop()
+}
+
+// Создаёт хранилище и его менеджер транзакций. Пул соединений
+// возвращается для метрик, он есть только у PostgreSQL
+func newStorage(cfg *config.Config) (storage, tracing.TransactionManager, *pgxpool.Pool) {
+	switch cfg.Storage.Driver {
+	case STORAGE_POSTGRES:
+		strategy, err := repositories.NewSelectionStrategy(cfg.Reviewers.Strategy)
+		if err != nil {
+			panic(err)
+		}
+
+		storage, err := repositories.New(
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.DBName,
+			cfg.Postgres.MaxConns,
+			trmpgx.DefaultCtxGetter,
+			strategy,
+			otelpgx.NewTracer(),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		txManager := manager.Must(trmpgx.NewDefaultFactory(storage.GetPool()))
+
+		return storage, txManager, storage.GetPool()
+	case STORAGE_SQLITE:
+		strategy, err := repositories.NewSelectionStrategy(cfg.Reviewers.Strategy)
+		if err != nil {
+			panic(err)
+		}
+
+		storage, err := sqlite.New(
+			cfg.SQLite.Path,
+			cfg.SQLite.MaxConns,
+			trmsql.DefaultCtxGetter,
+			strategy,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		txManager := manager.Must(trmsql.NewDefaultFactory(storage.GetDB()))
+
+		return storage, txManager, nil
+	case STORAGE_MEMORY:
+		storage, err := memory.New(cfg.Reviewers.Strategy)
+		if err != nil {
+			panic(err)
+		}
+
+		return storage, memory.NewTxManager(storage), nil
+	}
+
+	panic(fmt.Sprintf("unknown storage: %s", cfg.Storage.Driver))
+}
@@ -9,10 +9,27 @@ import (
 )
 
 type Config struct {
-	Host     string         `yaml:"host" env-default:"localhost"`
-	Port     int            `yaml:"port"`
-	Postgres PostgresConfig `yaml:"postgres"`
-	Timeout  time.Duration  `yaml:"timeout" env-default:"300ms"`
+	Host      string          `yaml:"host" env-default:"localhost"`
+	Port      int             `yaml:"port"`
+	Storage   StorageConfig   `yaml:"storage"`
+	Postgres  PostgresConfig  `yaml:"postgres"`
+	SQLite    SQLiteConfig    `yaml:"sqlite"`
+	Timeout   time.Duration   `yaml:"timeout" env-default:"300ms"`
+	Reviewers ReviewersConfig `yaml:"reviewers"`
+	Merge     MergeConfig     `yaml:"merge"`
+	Absence   AbsenceConfig   `yaml:"absence"`
+	Stats     StatsConfig     `yaml:"stats"`
+	Webhooks  WebhooksConfig  `yaml:"webhooks"`
+
+	Integrations IntegrationsConfig `yaml:"integrations"`
+	VCSSync      VCSSyncConfig      `yaml:"vcs_sync"`
+	Tracing      TracingConfig      `yaml:"tracing"`
+	Auth         AuthConfig         `yaml:"auth"`
+}
+
+type StorageConfig struct {
+	// Хранилище: postgres, sqlite или memory
+	Driver string `yaml:"driver" env-default:"postgres"`
 }
 
 type PostgresConfig struct {
@@ -24,6 +41,97 @@ type PostgresConfig struct {
 	MaxConns int32  `yaml:"max_conns"`
 }
 
+type SQLiteConfig struct {
+	// Путь к файлу базы данных
+	Path     string `yaml:"path" env-default:"pr-assignment.db"`
+	MaxConns int    `yaml:"max_conns" env-default:"4"`
+}
+
+type ReviewersConfig struct {
+	// Стратегия выбора ревьюверов: random или least_loaded
+	Strategy string `yaml:"strategy" env-default:"least_loaded"`
+	// Сколько пул реквестов /team/reassign переназначает параллельно
+	ReassignParallelism int `yaml:"reassign_parallelism" env-default:"4"`
+}
+
+type MergeConfig struct {
+	// Количество одобрений, необходимое для мерджа PR (0 - не требуется)
+	RequiredApprovals int `yaml:"required_approvals" env-default:"0"`
+}
+
+type AbsenceConfig struct {
+	// Интервал переназначения ревью отсутствующих пользователей (0 - не переназначать)
+	ReassignInterval time.Duration `yaml:"reassign_interval" env-default:"0s"`
+}
+
+type StatsConfig struct {
+	// Интервал свёртки времени до мерджа по дням (0 - не сворачивать)
+	RollupInterval time.Duration `yaml:"rollup_interval" env-default:"0s"`
+}
+
+type WebhooksConfig struct {
+	// Интервал отправки событий подписчикам (0 - не отправлять)
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env-default:"1s"`
+	// Количество событий, отправляемых за раз
+	BatchSize int `yaml:"batch_size" env-default:"50"`
+	// Количество событий, отправляемых одновременно
+	Parallelism int `yaml:"parallelism" env-default:"10"`
+	// Время, на которое событие блокируется для отправки. Увеличивается
+	// до времени отправки порции, если оно больше
+	Lease time.Duration `yaml:"lease" env-default:"30s"`
+	// Тайм-аут запроса к подписчику
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+	// Количество попыток отправки и задержки между ними
+	MaxAttempts int           `yaml:"max_attempts" env-default:"10"`
+	BackoffBase time.Duration `yaml:"backoff_base" env-default:"1s"`
+	BackoffMax  time.Duration `yaml:"backoff_max" env-default:"10m"`
+}
+
+type IntegrationsConfig struct {
+	// Секрет подписи вебхуков GitHub (пустой - вебхуки отклоняются)
+	GitHubSecret string `yaml:"github_secret"`
+	// Секретный токен вебхуков GitLab (пустой - вебхуки отклоняются)
+	GitLabToken string `yaml:"gitlab_token"`
+}
+
+type VCSSyncConfig struct {
+	// Интервал синхронизации ревьюверов с GitHub и GitLab (0 - не синхронизировать)
+	Interval time.Duration `yaml:"interval" env-default:"0s"`
+	// Адреса API и токены доступа. Система без токена не синхронизируется
+	GitHubURL   string `yaml:"github_url" env-default:"https://api.github.com"`
+	GitHubToken string `yaml:"github_token"`
+	GitLabURL   string `yaml:"gitlab_url" env-default:"https://gitlab.com"`
+	GitLabToken string `yaml:"gitlab_token"`
+	// Тайм-аут запроса к API
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+	// Количество задач, выполняемых за раз
+	BatchSize int `yaml:"batch_size" env-default:"20"`
+	// Количество задач, выполняемых одновременно
+	Parallelism int `yaml:"parallelism" env-default:"5"`
+	// Время, на которое задача блокируется для выполнения. Увеличивается
+	// до времени выполнения порции, если оно больше
+	Lease time.Duration `yaml:"lease" env-default:"1m"`
+	// Количество попыток и задержки между ними
+	MaxAttempts int           `yaml:"max_attempts" env-default:"10"`
+	BackoffBase time.Duration `yaml:"backoff_base" env-default:"5s"`
+	BackoffMax  time.Duration `yaml:"backoff_max" env-default:"30m"`
+}
+
+type TracingConfig struct {
+	// Экспорт спанов: none, stdout или otlp
+	Exporter string `yaml:"exporter" env-default:"none"`
+	// Адрес OTLP/HTTP коллектора
+	OTLPEndpoint string `yaml:"otlp_endpoint" env-default:"localhost:4318"`
+	ServiceName  string `yaml:"service_name" env-default:"pr-assignment"`
+	// Доля записываемых трейсов
+	SampleRatio float64 `yaml:"sample_ratio" env-default:"1"`
+}
+
+type AuthConfig struct {
+	// Токен администратора для выпуска первых токенов (пустой - не используется)
+	AdminToken string `yaml:"admin_token"`
+}
+
 func MustLoad() *Config {
 	configPath := fetchConfigPath()
 	if configPath == "" {
@@ -57,6 +165,21 @@ func (c *Config) LoadEnv() {
 	if c.Postgres.DBName == "" {
 		c.Postgres.DBName = os.Getenv("POSTGRES_DB")
 	}
+	if c.Integrations.GitHubSecret == "" {
+		c.Integrations.GitHubSecret = os.Getenv("GITHUB_WEBHOOK_SECRET")
+	}
+	if c.Integrations.GitLabToken == "" {
+		c.Integrations.GitLabToken = os.Getenv("GITLAB_WEBHOOK_TOKEN")
+	}
+	if c.VCSSync.GitHubToken == "" {
+		c.VCSSync.GitHubToken = os.Getenv("GITHUB_TOKEN")
+	}
+	if c.VCSSync.GitLabToken == "" {
+		c.VCSSync.GitLabToken = os.Getenv("GITLAB_TOKEN")
+	}
+	if c.Auth.AdminToken == "" {
+		c.Auth.AdminToken = os.Getenv("ADMIN_TOKEN")
+	}
 }
 
 func fetchConfigPath() string {
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Статистика пула соединений с БД, снимается при каждом сборе метрик
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(NAMESPACE, "db_pool", name),
+			help, nil, nil,
+		)
+	}
+
+	return &poolCollector{
+		pool: pool,
+
+		acquiredConns:        desc("acquired_conns", "Количество занятых соединений"),
+		idleConns:            desc("idle_conns", "Количество свободных соединений"),
+		totalConns:           desc("total_conns", "Количество открытых соединений"),
+		maxConns:             desc("max_conns", "Максимальное количество соединений"),
+		acquireCount:         desc("acquire_total", "Количество получений соединения"),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Суммарное время ожидания соединения"),
+		emptyAcquireCount:    desc("empty_acquire_total", "Количество получений соединения из пустого пула"),
+		canceledAcquireCount: desc("canceled_acquire_total", "Количество отменённых получений соединения"),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquireCount
+	ch <- c.canceledAcquireCount
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
+
+type OpenPullRequestsProvider interface {
+	GetOpenPullRequestsByTeam(
+		ctx context.Context,
+	) (map[string]int, error)
+}
+
+// Количество открытых пул реквестов по командам, считается в БД
+// при каждом сборе метрик, поэтому не расходится с данными после рестарта
+type openPullRequestsCollector struct {
+	teams   OpenPullRequestsProvider
+	timeout time.Duration
+
+	openPullRequests *prometheus.Desc
+}
+
+func newOpenPullRequestsCollector(
+	teams OpenPullRequestsProvider,
+	timeout time.Duration,
+) *openPullRequestsCollector {
+	return &openPullRequestsCollector{
+		teams:   teams,
+		timeout: timeout,
+
+		openPullRequests: prometheus.NewDesc(
+			prometheus.BuildFQName(NAMESPACE, "", "open_pull_requests"),
+			"Количество открытых пул реквестов по командам авторов",
+			[]string{"team"}, nil,
+		),
+	}
+}
+
+func (c *openPullRequestsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openPullRequests
+}
+
+func (c *openPullRequestsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	counts, err := c.teams.GetOpenPullRequestsByTeam(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.openPullRequests, err)
+		return
+	}
+
+	for team, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.openPullRequests, prometheus.GaugeValue, float64(count), team)
+	}
+}
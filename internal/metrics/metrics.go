@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Префикс имён всех метрик сервиса
+const NAMESPACE = "pr_assignment"
+
+// Ключ контекста gin, в который записывается ID операции OpenAPI
+const OPERATION_KEY = "metrics.operation"
+
+// Метрики сервиса в формате Prometheus
+type Metrics struct {
+	registry *prometheus.Registry
+
+	// HTTP запросы по операциям OpenAPI
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	// Метрики назначений
+	assignments   *prometheus.CounterVec
+	reassignments prometheus.Counter
+	noCandidates  prometheus.Counter
+}
+
+func New(
+	pool *pgxpool.Pool,
+	teams OpenPullRequestsProvider,
+	scrapeTimeout time.Duration,
+) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "http_requests_total",
+			Help:      "Количество HTTP запросов по операциям и кодам ответа",
+		}, []string{"operation", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Name:      "http_request_duration_seconds",
+			Help:      "Время обработки HTTP запросов по операциям",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+
+		assignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "reviewer_assignments_total",
+			Help:      "Количество назначений ревьювером по пользователям",
+		}, []string{"user_id"}),
+		reassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Количество замен ревьюверов",
+		}),
+		noCandidates: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "no_candidates_total",
+			Help:      "Количество замен ревьюверов, для которых не нашлось кандидата",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.duration,
+		m.assignments,
+		m.reassignments,
+		m.noCandidates,
+		newOpenPullRequestsCollector(teams, scrapeTimeout),
+	)
+
+	// У хранилища в памяти нет пула соединений
+	if pool != nil {
+		m.registry.MustRegister(newPoolCollector(pool))
+	}
+
+	return m
+}
+
+// Обработчик /metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Записывает ID операции OpenAPI в контекст gin,
+// чтобы Middleware подписал им метрики запроса
+func (m *Metrics) StrictMiddleware() api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx *gin.Context, request any) (any, error) {
+			ctx.Set(OPERATION_KEY, operationID)
+			return f(ctx, request)
+		}
+	}
+}
+
+// Считает запросы и время их обработки. Код ответа известен только
+// после записи ответа, поэтому метрики пишутся здесь, а не в StrictMiddleware.
+// Запросы вне спецификации (например, сам /metrics) не учитываются
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		operation := c.GetString(OPERATION_KEY)
+		if operation == "" {
+			return
+		}
+
+		m.requests.WithLabelValues(operation, strconv.Itoa(c.Writer.Status())).Inc()
+		m.duration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	}
+}
+
+func (m *Metrics) ReviewerAssigned(reviewerID string) {
+	m.assignments.WithLabelValues(reviewerID).Inc()
+}
+
+func (m *Metrics) ReviewerReassigned() {
+	m.reassignments.Inc()
+}
+
+func (m *Metrics) NoCandidates() {
+	m.noCandidates.Inc()
+}
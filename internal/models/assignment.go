@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Причина назначения ревьювера
+type AssignmentReason = string
+
+const (
+	// Назначен, когда PR стал доступен для ревью: создан, готов или переоткрыт
+	ASSIGNMENT_INITIAL AssignmentReason = "initial"
+	// Назначен вместо ревьювера, переназначенного вручную
+	ASSIGNMENT_MANUAL_REASSIGN AssignmentReason = "manual_reassign"
+	// Доназначен при переназначении команды
+	ASSIGNMENT_TEAM_REASSIGN AssignmentReason = "team_reassign"
+	// Назначен вместо деактивированного ревьювера
+	ASSIGNMENT_DEACTIVATION AssignmentReason = "deactivation"
+	// Назначен вместо отсутствующего ревьювера
+	ASSIGNMENT_ABSENCE AssignmentReason = "absence"
+	// Указан вызывающим при массовом создании PR
+	ASSIGNMENT_IMPORT AssignmentReason = "import"
+)
+
+// Назначение ревьювера на PR. Снятый ревьювер остаётся в истории
+// с заполненным UnassignedAt
+type ReviewerAssignment struct {
+	ReviewerID   string
+	Reason       AssignmentReason
+	Verdict      ReviewVerdict // Пустой, если ревьювер не оставил вердикт
+	AssignedAt   time.Time
+	UnassignedAt time.Time
+}
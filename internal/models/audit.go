@@ -0,0 +1,94 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditOperation = string
+
+const (
+	AUDIT_TEAM_ADD             AuditOperation = "team.add"
+	AUDIT_TEAM_UPDATE          AuditOperation = "team.update"
+	AUDIT_TEAM_DEACTIVATE      AuditOperation = "team.deactivate"
+	AUDIT_TEAM_REASSIGN        AuditOperation = "team.reassign"
+	AUDIT_USER_SET_IS_ACTIVE   AuditOperation = "user.set_is_active"
+	AUDIT_USER_SET_MAX_REVIEWS AuditOperation = "user.set_max_open_reviews"
+	AUDIT_ABSENCE_ADD          AuditOperation = "absence.add"
+	AUDIT_ABSENCE_DELETE       AuditOperation = "absence.delete"
+	AUDIT_ABSENCE_REASSIGN     AuditOperation = "absence.reassign"
+	AUDIT_PR_CREATE            AuditOperation = "pull_request.create"
+	AUDIT_PR_MERGE             AuditOperation = "pull_request.merge"
+	AUDIT_PR_REASSIGN          AuditOperation = "pull_request.reassign"
+	AUDIT_PR_REVIEW            AuditOperation = "pull_request.review"
+	AUDIT_PR_MARK_READY        AuditOperation = "pull_request.mark_ready"
+	AUDIT_PR_CLOSE             AuditOperation = "pull_request.close"
+	AUDIT_PR_REOPEN            AuditOperation = "pull_request.reopen"
+)
+
+// Запись журнала изменений. Снимки объекта до и после изменения
+// хранятся в JSON, nil - объекта не было (или не стало)
+type AuditEvent struct {
+	ID            int64
+	Actor         string
+	Operation     AuditOperation
+	PullRequestID string
+	TeamName      string
+	UserID        string
+	Before        json.RawMessage
+	After         json.RawMessage
+	RequestID     string
+	OccurredAt    time.Time
+}
+
+// Фильтр журнала изменений. Пустые значения полей не фильтруют
+type AuditFilter struct {
+	PullRequestID string
+	TeamName      string
+	UserID        string
+	From          time.Time
+	To            time.Time
+
+	Limit int
+
+	// Позиция, после которой начинается страница
+	After *AuditCursor
+}
+
+// Позиция записи в журнале, записи идут от новых к старым
+type AuditCursor struct {
+	ID int64 `json:"id"`
+}
+
+// Снимки объектов в журнале изменений
+
+type AuditPullRequest struct {
+	PullRequestID     string                   `json:"pull_request_id"`
+	PullRequestName   string                   `json:"pull_request_name"`
+	AuthorID          string                   `json:"author_id"`
+	Status            PRStatus                 `json:"status"`
+	AssignedReviewers []string                 `json:"assigned_reviewers"`
+	Reviews           map[string]ReviewVerdict `json:"reviews,omitempty"`
+}
+
+type AuditTeam struct {
+	TeamName       string      `json:"team_name"`
+	ReviewersCount int         `json:"reviewers_count"`
+	Members        []AuditUser `json:"members"`
+}
+
+type AuditUser struct {
+	UserID         string `json:"user_id"`
+	Username       string `json:"username"`
+	TeamName       string `json:"team_name,omitempty"`
+	IsActive       bool   `json:"is_active"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+type AuditAbsence struct {
+	AbsenceID int64     `json:"absence_id"`
+	UserID    string    `json:"user_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason,omitempty"`
+}
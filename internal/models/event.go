@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+type EventType = string
+
+const (
+	EVENT_PR_CREATED          EventType = "pull_request.created"
+	EVENT_PR_MERGED           EventType = "pull_request.merged"
+	EVENT_REVIEWER_ASSIGNED   EventType = "reviewer.assigned"
+	EVENT_REVIEWER_REASSIGNED EventType = "reviewer.reassigned"
+	EVENT_USER_DEACTIVATED    EventType = "user.deactivated"
+)
+
+// Все типы событий, на которые можно подписаться
+var EventTypes = []EventType{
+	EVENT_PR_CREATED,
+	EVENT_PR_MERGED,
+	EVENT_REVIEWER_ASSIGNED,
+	EVENT_REVIEWER_REASSIGNED,
+	EVENT_USER_DEACTIVATED,
+}
+
+// Событие сервиса, в таком виде оно отправляется подписчикам
+type Event struct {
+	Type       EventType `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// Данные событий pull_request.*
+type PullRequestEventData struct {
+	PullRequestID     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	Status            PRStatus `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+}
+
+// Данные событий reviewer.*
+type ReviewerEventData struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+}
+
+// Данные событий user.*
+type UserEventData struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
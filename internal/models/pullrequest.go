@@ -5,8 +5,10 @@ import "time"
 type PRStatus = string
 
 const (
+	PULLREQUEST_DRAFT  PRStatus = "DRAFT"
 	PULLREQUEST_OPEN   PRStatus = "OPEN"
 	PULLREQUEST_MERGED PRStatus = "MERGED"
+	PULLREQUEST_CLOSED PRStatus = "CLOSED"
 )
 
 type PullRequest struct {
@@ -15,6 +17,51 @@ type PullRequest struct {
 	AuthorID          string
 	Status            PRStatus
 	AssignedReviewers []string
+	Reviews           []Review // Вердикты ревьюверов, которые их оставили
+	ReviewersMissing  int      // Сколько ревьюверов не хватает до количества, заданного командой автора
 	CreatedAt         time.Time
 	MergedAt          time.Time
 }
+
+// Итог создания пул реквеста при массовом создании
+type PullRequestCreateResult struct {
+	PullRequest PullRequest // Созданный пул реквест или переданный, если создать не удалось
+	Err         error       // Пустая, если пул реквест создан
+}
+
+// Поля, по которым можно сортировать список PR
+type PRSortField = string
+
+const (
+	PULLREQUEST_SORT_CREATED_AT PRSortField = "created_at"
+	PULLREQUEST_SORT_MERGED_AT  PRSortField = "merged_at"
+)
+
+// Фильтр списка PR. Пустые значения полей не фильтруют
+type PullRequestFilter struct {
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Status      PRStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	MergedFrom  time.Time
+	MergedTo    time.Time
+
+	SortBy     PRSortField
+	Descending bool
+	Limit      int
+
+	// Позиция, после которой начинается страница
+	After *PullRequestCursor
+}
+
+// Позиция PR в отсортированном списке. Поле и направление сортировки
+// сохраняются, чтобы курсор нельзя было применить к другому порядку.
+// Нулевое SortValue при сортировке по merged_at - несмердженный PR
+type PullRequestCursor struct {
+	SortBy     PRSortField `json:"s"`
+	Descending bool        `json:"d"`
+	SortValue  time.Time   `json:"v"`
+	ID         string      `json:"id"`
+}
@@ -1,6 +1,37 @@
 package models
 
+import "slices"
+
+// Итог переназначения ревьювера в пул реквесте
+type ReassignmentOutcome = string
+
+const (
+	REASSIGNMENT_REASSIGNED   ReassignmentOutcome = "REASSIGNED"
+	REASSIGNMENT_NO_CANDIDATE ReassignmentOutcome = "NO_CANDIDATE"
+	REASSIGNMENT_PR_MERGED    ReassignmentOutcome = "PR_MERGED"
+	REASSIGNMENT_PR_NOT_OPEN  ReassignmentOutcome = "PR_NOT_OPEN"
+
+	// Ревьювера уже заменила параллельная транзакция
+	REASSIGNMENT_ALREADY_REPLACED ReassignmentOutcome = "ALREADY_REPLACED"
+)
+
 type Reassignment struct {
-	OldReviewer string
-	NewReviewer string
+	PullRequestID string
+	OldReviewer   string
+	NewReviewer   string // Пустой, если ревьювер не заменён
+	Outcome       ReassignmentOutcome
+}
+
+// Пул реквесты, в которых не нашлось кандидата на замену ревьювера,
+// в порядке первого упоминания
+func UnresolvedPullRequests(reassignments []Reassignment) []string {
+	unresolved := make([]string, 0)
+	for _, reassignment := range reassignments {
+		if reassignment.Outcome == REASSIGNMENT_NO_CANDIDATE &&
+			!slices.Contains(unresolved, reassignment.PullRequestID) {
+			unresolved = append(unresolved, reassignment.PullRequestID)
+		}
+	}
+
+	return unresolved
 }
@@ -0,0 +1,14 @@
+package models
+
+type ReviewVerdict = string
+
+const (
+	REVIEW_APPROVED          ReviewVerdict = "APPROVED"
+	REVIEW_CHANGES_REQUESTED ReviewVerdict = "CHANGES_REQUESTED"
+	REVIEW_COMMENTED         ReviewVerdict = "COMMENTED"
+)
+
+type Review struct {
+	ReviewerID string
+	Verdict    ReviewVerdict
+}
@@ -1,15 +1,21 @@
 package models
 
+// Количество ревьюверов на PR, если команда его не задала
+const DEFAULT_REVIEWERS_COUNT = 2
+
 type Team struct {
-	TeamName string
-	Members  []User
+	TeamName       string
+	ReviewersCount int
+	Members        []User
 }
 
 type TeamStats struct {
 	TeamName           string
 	PullRequests       int
+	DraftPullRequests  int
 	OpenPullRequests   int
 	MergedPullRequests int
+	ClosedPullRequests int
 	Users              int
 	ActiveUsers        int
 	InactiveUsers      int
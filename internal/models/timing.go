@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Границы корзин возраста открытых PR. Последняя корзина не ограничена сверху
+var OPEN_AGE_BOUNDS = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	3 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+// Названия корзин возраста открытых PR, на одну больше чем границ
+var OPEN_AGE_BUCKETS = []string{"lt_1h", "1h_1d", "1d_3d", "3d_7d", "gte_7d"}
+
+// Фильтр временной статистики команды
+type TimingFilter struct {
+	// Окно времени: учитываются PR, смердженные или созданные
+	// в [From, To). Нулевой From - без нижней границы
+	From time.Time
+	To   time.Time
+
+	// Количество самых старых открытых PR в ответе
+	OldestLimit int
+}
+
+// Перцентили времени от создания до мерджа PR
+type MergeTimes struct {
+	MergedPullRequests int
+	P50                time.Duration
+	P90                time.Duration
+	P99                time.Duration
+}
+
+type AgeBucket struct {
+	Name  string
+	Count int
+}
+
+type TeamTimingStats struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+
+	MergeTimes MergeTimes
+
+	OpenPullRequests int
+	OpenAge          []AgeBucket
+	OldestOpen       []PullRequest
+}
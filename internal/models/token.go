@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+type Role string
+
+const (
+	ROLE_ADMIN           Role = "admin"
+	ROLE_TEAM_MAINTAINER Role = "team-maintainer"
+	ROLE_BOT             Role = "bot"
+	ROLE_READ_ONLY       Role = "read-only"
+)
+
+var Roles = []Role{
+	ROLE_ADMIN,
+	ROLE_TEAM_MAINTAINER,
+	ROLE_BOT,
+	ROLE_READ_ONLY,
+}
+
+// Токен доступа к API. Сам токен не хранится, только его хеш
+type APIToken struct {
+	ID        int64
+	Name      string
+	Role      Role
+	TeamName  string // Команда, которой управляет team-maintainer
+	CreatedAt time.Time
+	RevokedAt time.Time // Нулевое значение - токен не отозван
+}
+
+// Действие, на которое проверяются права токена
+type Permission string
+
+const (
+	// Чтение команд, пользователей, PR и статистики
+	PERMISSION_READ Permission = "read"
+	// Создание и изменение PR
+	PERMISSION_PULL_REQUESTS Permission = "pull_requests"
+	// Изменение команд и их пользователей
+	PERMISSION_TEAMS Permission = "teams"
+	// Подписки, интеграции и токены
+	PERMISSION_ADMIN Permission = "admin"
+)
+
+// Объект, к которому относится действие. Для team-maintainer
+// по нему определяется команда, заполняется не больше одного поля
+type AuthScope struct {
+	TeamName      string
+	UserID        string
+	PullRequestID string
+}
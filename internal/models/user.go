@@ -1,9 +1,29 @@
 package models
 
+import "time"
+
 type User struct {
 	UserID   string
 	Username string
 	TeamID   int64 // Для внесения в БД использует ID команды
 	TeamName string
 	IsActive bool
+
+	// Максимальное количество открытых ревью (nil - без ограничения)
+	MaxOpenReviews *int
+}
+
+type UserStats struct {
+	UserID   string
+	Username string
+	TeamName string
+
+	ReviewsAssigned      int // Все назначения ревьювером, включая снятые
+	OpenReviews          int // Текущие назначения на открытые PR
+	MergedReviews        int // Текущие назначения на смердженные PR
+	PullRequestsAuthored int
+	ReassignedAway       int // Сколько раз ревьювера сняли с PR
+
+	// Медиана времени от назначения до мерджа (0 - смердженных ревью нет)
+	MedianTimeToMerge time.Duration
 }
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type VCSProvider = string
+
+const (
+	VCS_GITHUB VCSProvider = "github"
+	VCS_GITLAB VCSProvider = "gitlab"
+)
+
+// Действие с пул реквестом в системе контроля версий
+type VCSAction = string
+
+const (
+	VCS_ACTION_OPENED   VCSAction = "opened"
+	VCS_ACTION_READY    VCSAction = "ready_for_review"
+	VCS_ACTION_MERGED   VCSAction = "merged"
+	VCS_ACTION_CLOSED   VCSAction = "closed"
+	VCS_ACTION_REOPENED VCSAction = "reopened"
+	VCS_ACTION_IGNORED  VCSAction = "ignored" // Событие не относится к сервису
+)
+
+// Событие пул реквеста, полученное от системы контроля версий
+type VCSPullRequestEvent struct {
+	Provider         VCSProvider
+	Action           VCSAction
+	PullRequestID    string
+	Name             string
+	AuthorLogin      string
+	AuthorExternalID int // Числовой ID автора, если его логина нет в событии
+	Draft            bool
+}
+
+// Соответствие логина в системе контроля версий пользователю сервиса
+type VCSLogin struct {
+	Provider VCSProvider
+	Login    string
+	UserID   string
+}
+
+// Формирует ID пул реквеста из репозитория и его номера:
+// owner/repo#number для GitHub и group/project!iid для GitLab
+func VCSPullRequestID(provider VCSProvider, repository string, number int) string {
+	separator := "#"
+	if provider == VCS_GITLAB {
+		separator = "!"
+	}
+
+	return fmt.Sprintf("%s%s%d", repository, separator, number)
+}
+
+// Разбирает ID пул реквеста, созданного из системы контроля версий.
+// ok = false, если PR был создан не из неё
+func ParseVCSPullRequestID(id string) (provider VCSProvider, repository string, number int, ok bool) {
+	i := strings.LastIndexAny(id, "#!")
+	if i <= 0 {
+		return "", "", 0, false
+	}
+
+	number, err := strconv.Atoi(id[i+1:])
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+
+	provider = VCS_GITHUB
+	if id[i] == '!' {
+		provider = VCS_GITLAB
+	}
+
+	return provider, id[:i], number, true
+}
+
+// Задача синхронизации ревьюверов с системой контроля версий
+type VCSSyncJob struct {
+	ID         int64
+	Provider   VCSProvider
+	Repository string
+	Number     int
+	// Логины, которых нужно запросить ревьюверами
+	RequestLogins []string
+	// Логины, которых нужно убрать из ревьюверов
+	RemoveLogins []string
+	Attempts     int
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+type Webhook struct {
+	ID        int64
+	URL       string
+	Secret    string
+	Events    []EventType // Пустой список - подписка на все события
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+// Изменение подписки, nil поля не меняются
+type WebhookUpdate struct {
+	URL      *string
+	Secret   *string
+	Events   *[]EventType
+	IsActive *bool
+}
+
+// Доставка события подписчику из исходящей очереди
+type WebhookDelivery struct {
+	ID       int64
+	Event    EventType
+	Payload  []byte
+	Attempts int
+	URL      string
+	Secret   string
+}
@@ -0,0 +1,227 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Условие, исключающее кандидатов, отсутствующих в момент времени $N.
+// Кандидаты выбираются из таблицы users с псевдонимом u
+func notAbsentCondition(param int) string {
+	return fmt.Sprintf(
+		`
+		NOT EXISTS (
+			SELECT 1
+			FROM absences a
+			WHERE a.user_id = u.id AND a.starts_at <= $%d AND a.ends_at > $%d
+		)
+		`,
+		param, param,
+	)
+}
+
+// Добавляет период отсутствия пользователя
+func (s *Storage) AddAbsence(
+	ctx context.Context,
+	absence models.Absence,
+) (int64, error) {
+	const op = "repositories.postgres.AddAbsence"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Вставляем период отсутствия, если пользователь существует
+	res := conn.QueryRow(
+		ctx,
+		`
+		INSERT INTO absences (user_id, starts_at, ends_at, reason)
+		SELECT id, $2, $3, $4
+		FROM users
+		WHERE user_id = $1
+		RETURNING id;
+		`,
+		absence.UserID, absence.StartsAt, absence.EndsAt, absence.Reason,
+	)
+
+	var id int64
+	err := res.Scan(&id)
+	if err != nil {
+		// Пользователь не найден
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, ErrNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// Возвращает периоды отсутствия пользователя
+func (s *Storage) GetAbsences(
+	ctx context.Context,
+	userID string,
+) ([]models.Absence, error) {
+	const op = "repositories.postgres.GetAbsences"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Получаем пользователя и его периоды отсутствия, у пользователя
+	// без них будет одна строка с пустыми полями периода
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT a.id, a.starts_at, a.ends_at, a.reason
+		FROM users u
+		LEFT JOIN absences a ON a.user_id = u.id
+		WHERE u.user_id = $1
+		ORDER BY a.starts_at, a.id;
+		`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	found := false
+	absences := make([]models.Absence, 0)
+	for rows.Next() {
+		var id *int64
+		var startsAt, endsAt *time.Time
+		var reason *string
+		err := rows.Scan(&id, &startsAt, &endsAt, &reason)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		found = true
+
+		// У пользователя нет периодов отсутствия
+		if id == nil {
+			continue
+		}
+
+		absences = append(absences, models.Absence{
+			ID:       *id,
+			UserID:   userID,
+			StartsAt: *startsAt,
+			EndsAt:   *endsAt,
+			Reason:   *reason,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if !found {
+		return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return absences, nil
+}
+
+// Возвращает начавшиеся периоды отсутствия, по которым
+// ещё не переназначались ревью
+func (s *Storage) GetStartedAbsences(
+	ctx context.Context,
+	now time.Time,
+) ([]models.Absence, error) {
+	const op = "repositories.postgres.GetStartedAbsences"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT a.id, u.user_id, a.starts_at, a.ends_at, a.reason
+		FROM absences a
+		JOIN users u ON a.user_id = u.id
+		WHERE a.starts_at <= $1 AND a.ends_at > $1 AND a.reassigned = FALSE
+		ORDER BY a.starts_at, a.id;
+		`,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	absences := make([]models.Absence, 0)
+	for rows.Next() {
+		var absence models.Absence
+		err := rows.Scan(
+			&absence.ID,
+			&absence.UserID,
+			&absence.StartsAt,
+			&absence.EndsAt,
+			&absence.Reason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		absences = append(absences, absence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return absences, nil
+}
+
+// Удаляет период отсутствия пользователя
+func (s *Storage) DeleteAbsence(
+	ctx context.Context,
+	userID string,
+	absenceID int64,
+) error {
+	const op = "repositories.postgres.DeleteAbsence"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	tag, err := conn.Exec(
+		ctx,
+		`
+		DELETE FROM absences
+		WHERE
+			id = $1 AND
+			user_id = (SELECT id FROM users WHERE user_id = $2);
+		`,
+		absenceID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь или его период отсутствия не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Помечает, что ревью пользователя на период отсутствия переназначены
+func (s *Storage) MarkAbsenceReassigned(
+	ctx context.Context,
+	absenceID int64,
+) error {
+	const op = "repositories.postgres.MarkAbsenceReassigned"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	_, err := conn.Exec(
+		ctx,
+		`UPDATE absences SET reassigned = TRUE WHERE id = $1`,
+		absenceID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -8,4 +8,10 @@ var (
 	ErrUserExists   = errors.New("user already exists")
 	ErrPRExists     = errors.New("PR already exists")
 	ErrNoCandidates = errors.New("no candidate found")
+
+	// Нарушения ограничений таблицы reviewers
+	ErrReviewerAssigned = errors.New("reviewer already assigned")
+	ErrAuthorReviewer   = errors.New("author cannot review own PR")
+
+	ErrUnknownStrategy = errors.New("unknown selection strategy")
 )
@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет период отсутствия пользователя
+func (s *Storage) AddAbsence(
+	ctx context.Context,
+	absence models.Absence,
+) (int64, error) {
+	const op = "repositories.memory.AddAbsence"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[absence.UserID]; !ok {
+		return 0, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	s.data.lastAbsenceID++
+	absence.ID = s.data.lastAbsenceID
+	s.data.absences[absence.ID] = absenceRow{Absence: absence}
+
+	return absence.ID, nil
+}
+
+// Возвращает периоды отсутствия пользователя
+func (s *Storage) GetAbsences(
+	ctx context.Context,
+	userID string,
+) ([]models.Absence, error) {
+	const op = "repositories.memory.GetAbsences"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[userID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return s.data.sortedAbsences(func(a *absenceRow) bool {
+		return a.UserID == userID
+	}), nil
+}
+
+// Возвращает начавшиеся периоды отсутствия, по которым
+// ещё не переназначались ревью
+func (s *Storage) GetStartedAbsences(
+	ctx context.Context,
+	now time.Time,
+) ([]models.Absence, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	return s.data.sortedAbsences(func(a *absenceRow) bool {
+		return a.covers(now) && !a.reassigned
+	}), nil
+}
+
+// Удаляет период отсутствия пользователя
+func (s *Storage) DeleteAbsence(
+	ctx context.Context,
+	userID string,
+	absenceID int64,
+) error {
+	const op = "repositories.memory.DeleteAbsence"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Пользователь или его период отсутствия не найден
+	a, ok := s.data.absences[absenceID]
+	if !ok || a.UserID != userID {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	delete(s.data.absences, absenceID)
+
+	return nil
+}
+
+// Помечает, что ревью пользователя на период отсутствия переназначены
+func (s *Storage) MarkAbsenceReassigned(
+	ctx context.Context,
+	absenceID int64,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	a, ok := s.data.absences[absenceID]
+	if !ok {
+		return nil
+	}
+
+	a.reassigned = true
+	s.data.absences[absenceID] = a
+
+	return nil
+}
+
+// Приходится ли момент now на период отсутствия
+func (a *absenceRow) covers(now time.Time) bool {
+	return !a.StartsAt.After(now) && a.EndsAt.After(now)
+}
+
+// Отсутствует ли пользователь в момент now
+func (s *state) isAbsent(userID string, now time.Time) bool {
+	for _, a := range s.absences {
+		if a.UserID == userID && a.covers(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Периоды отсутствия, подходящие под условие, по времени начала
+func (s *state) sortedAbsences(match func(a *absenceRow) bool) []models.Absence {
+	absences := make([]models.Absence, 0)
+	for _, a := range s.absences {
+		if match(&a) {
+			absences = append(absences, a.Absence)
+		}
+	}
+
+	slices.SortFunc(absences, func(a, b models.Absence) int {
+		if c := a.StartsAt.Compare(b.StartsAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return absences
+}
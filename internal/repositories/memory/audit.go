@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Добавляет запись в журнал изменений. Вызывается в транзакции
+// изменения, поэтому запись сохраняется только вместе с ним
+func (s *Storage) AddAuditEvent(
+	ctx context.Context,
+	event models.AuditEvent,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	event.ID = int64(len(s.data.auditEvents) + 1)
+	event.Before = slices.Clone(event.Before)
+	event.After = slices.Clone(event.After)
+	s.data.auditEvents = append(s.data.auditEvents, event)
+
+	return nil
+}
+
+// Получает страницу журнала изменений от новых записей к старым
+func (s *Storage) ListAuditEvents(
+	ctx context.Context,
+	filter models.AuditFilter,
+) ([]models.AuditEvent, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	events := make([]models.AuditEvent, 0)
+	for i := len(s.data.auditEvents) - 1; i >= 0 && len(events) < filter.Limit; i-- {
+		event := s.data.auditEvents[i]
+
+		if filter.PullRequestID != "" && event.PullRequestID != filter.PullRequestID ||
+			filter.TeamName != "" && event.TeamName != filter.TeamName ||
+			filter.UserID != "" && event.UserID != filter.UserID ||
+			!filter.From.IsZero() && event.OccurredAt.Before(filter.From) ||
+			!filter.To.IsZero() && !event.OccurredAt.Before(filter.To) {
+			continue
+		}
+		// Страница начинается строго после курсора
+		if filter.After != nil && event.ID >= filter.After.ID {
+			continue
+		}
+
+		event.Before = slices.Clone(event.Before)
+		event.After = slices.Clone(event.After)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,206 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Хранилище в памяти процесса. Реализует те же методы, что и
+// repositories.Storage, и используется в тестах и для демонстрации
+// сервиса без БД. Данные теряются при остановке
+type Storage struct {
+	// Удерживается транзакцией, пока она не завершится.
+	// Операции вне транзакции ждут её завершения
+	txMu sync.Mutex
+	// Защищает данные на время одной операции
+	mu sync.Mutex
+
+	data     *state
+	strategy string
+}
+
+// Строки таблиц. Хранятся по значению, чтобы снимок
+// для отката транзакции копировался без общих данных
+
+type teamRow struct {
+	id             int64
+	name           string
+	reviewersCount int
+}
+
+type userRow struct {
+	seq            int64 // Порядок добавления
+	username       string
+	teamID         int64
+	isActive       bool
+	maxOpenReviews *int
+}
+
+type pullRequestRow struct {
+	seq       int64
+	name      string
+	authorID  string
+	status    models.PRStatus
+	createdAt time.Time
+	mergedAt  time.Time
+}
+
+type reviewerRow struct {
+	pullRequestID string
+	userID        string
+	reason        models.AssignmentReason
+	verdict       models.ReviewVerdict // Пустой - вердикта нет
+	assignedAt    time.Time
+	unassignedAt  time.Time // Нулевое - ревьювер назначен сейчас
+}
+
+type absenceRow struct {
+	models.Absence
+	reassigned bool
+}
+
+type tokenRow struct {
+	models.APIToken
+	hash string
+}
+
+type vcsLoginKey struct {
+	provider models.VCSProvider
+	login    string
+}
+
+// Состояние задачи синхронизации или доставки события
+type attempt struct {
+	nextAttemptAt time.Time
+	lockedUntil   time.Time
+	doneAt        time.Time
+	failedAt      time.Time
+	lastError     string
+}
+
+// Готова ли задача к выполнению в момент now
+func (a *attempt) ready(now time.Time) bool {
+	return a.doneAt.IsZero() &&
+		a.failedAt.IsZero() &&
+		!a.nextAttemptAt.After(now) &&
+		!a.lockedUntil.After(now)
+}
+
+type vcsSyncJob struct {
+	models.VCSSyncJob
+	attempt
+}
+
+type delivery struct {
+	models.WebhookDelivery
+	webhookID int64
+	attempt
+}
+
+// Все данные хранилища
+type state struct {
+	teams        map[int64]teamRow
+	users        map[string]userRow
+	pullRequests map[string]pullRequestRow
+	reviewers    []reviewerRow // История назначений в порядке назначения
+	absences     map[int64]absenceRow
+	auditEvents  []models.AuditEvent // ID записи - её номер в журнале
+	tokens       []tokenRow          // ID токена - его номер в списке
+	vcsLogins    map[vcsLoginKey]string
+	vcsSyncJobs  []vcsSyncJob
+	webhooks     map[int64]models.Webhook
+	deliveries   []delivery
+
+	// Последние выданные ID
+	lastTeamID     int64
+	lastUserSeq    int64
+	lastPRSeq      int64
+	lastAbsenceID  int64
+	lastWebhookID  int64
+	lastDeliveryID int64
+}
+
+func newState() *state {
+	return &state{
+		teams:        map[int64]teamRow{},
+		users:        map[string]userRow{},
+		pullRequests: map[string]pullRequestRow{},
+		reviewers:    []reviewerRow{},
+		absences:     map[int64]absenceRow{},
+		auditEvents:  []models.AuditEvent{},
+		tokens:       []tokenRow{},
+		vcsLogins:    map[vcsLoginKey]string{},
+		vcsSyncJobs:  []vcsSyncJob{},
+		webhooks:     map[int64]models.Webhook{},
+		deliveries:   []delivery{},
+	}
+}
+
+// Копирует состояние. Срезы внутри строк не меняются
+// после записи, поэтому копируются только сами таблицы
+func (s *state) clone() *state {
+	c := *s
+
+	c.teams = maps.Clone(s.teams)
+	c.users = maps.Clone(s.users)
+	c.pullRequests = maps.Clone(s.pullRequests)
+	c.reviewers = slices.Clone(s.reviewers)
+	c.absences = maps.Clone(s.absences)
+	c.auditEvents = slices.Clone(s.auditEvents)
+	c.tokens = slices.Clone(s.tokens)
+	c.vcsLogins = maps.Clone(s.vcsLogins)
+	c.vcsSyncJobs = slices.Clone(s.vcsSyncJobs)
+	c.webhooks = maps.Clone(s.webhooks)
+	c.deliveries = slices.Clone(s.deliveries)
+
+	return &c
+}
+
+func New(strategy string) (*Storage, error) {
+	const op = "repositories.memory.New"
+
+	switch strategy {
+	case repositories.STRATEGY_RANDOM, repositories.STRATEGY_LEAST_LOADED:
+	default:
+		return nil, fmt.Errorf("%s: %w: %s", op, repositories.ErrUnknownStrategy, strategy)
+	}
+
+	return &Storage{
+		data:     newState(),
+		strategy: strategy,
+	}, nil
+}
+
+func (s *Storage) Stop() {}
+
+// Ключ контекста, которым транзакция помечает свои операции
+type txKey struct{}
+
+// Выполняется ли операция внутри транзакции этого хранилища
+func (s *Storage) inTx(ctx context.Context) bool {
+	storage, _ := ctx.Value(txKey{}).(*Storage)
+	return storage == s
+}
+
+// Блокирует хранилище на время операции и возвращает функцию разблокировки.
+// Операция вне транзакции сначала дожидается завершения текущей транзакции
+func (s *Storage) lock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		s.mu.Lock()
+		return s.mu.Unlock
+	}
+
+	s.txMu.Lock()
+	s.mu.Lock()
+	return func() {
+		s.mu.Unlock()
+		s.txMu.Unlock()
+	}
+}
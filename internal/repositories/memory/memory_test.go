@@ -0,0 +1,264 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStorage(t *testing.T) (*Storage, *TxManager) {
+	t.Helper()
+
+	s, err := New(repositories.STRATEGY_RANDOM)
+	require.NoError(t, err)
+
+	return s, NewTxManager(s)
+}
+
+func TestNew_UnknownStrategy(t *testing.T) {
+	_, err := New("round_robin")
+	require.ErrorIs(t, err, repositories.ErrUnknownStrategy)
+}
+
+func TestTxManager_RollbackOnError(t *testing.T) {
+	s, txManager := newStorage(t)
+	ctx := context.Background()
+
+	errFailed := errors.New("failed")
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		_, err := s.AddTeam(ctx, "backend", 1)
+		require.NoError(t, err)
+
+		_, err = s.GetTeam(ctx, "backend")
+		require.NoError(t, err)
+
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	_, err = s.GetTeam(ctx, "backend")
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+
+	// Счётчики ID тоже откатываются
+	teamID, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, teamID)
+}
+
+func TestTxManager_RollbackOnPanic(t *testing.T) {
+	s, txManager := newStorage(t)
+	ctx := context.Background()
+
+	assert.Panics(t, func() {
+		_ = txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := s.AddTeam(ctx, "backend", 1)
+			require.NoError(t, err)
+
+			panic("boom")
+		})
+	})
+
+	_, err := s.GetTeam(ctx, "backend")
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+
+	// Хранилище не осталось заблокированным
+	require.NoError(t, txManager.Do(ctx, func(ctx context.Context) error {
+		_, err := s.AddTeam(ctx, "backend", 1)
+		return err
+	}))
+}
+
+func TestTxManager_Nested(t *testing.T) {
+	s, txManager := newStorage(t)
+	ctx := context.Background()
+
+	errFailed := errors.New("failed")
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		err := txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := s.AddTeam(ctx, "backend", 1)
+			return err
+		})
+		require.NoError(t, err)
+
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	// Вложенная транзакция откатывается вместе с внешней
+	_, err = s.GetTeam(ctx, "backend")
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+}
+
+func TestTxManager_Commit(t *testing.T) {
+	s, txManager := newStorage(t)
+	ctx := context.Background()
+
+	require.NoError(t, txManager.Do(ctx, func(ctx context.Context) error {
+		teamID, err := s.AddTeam(ctx, "backend", 1)
+		if err != nil {
+			return err
+		}
+
+		return s.AddUser(ctx, models.User{
+			UserID:   "u1",
+			Username: "Alice",
+			TeamID:   teamID,
+			IsActive: true,
+		})
+	}))
+
+	team, err := s.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, team.Members, 1)
+	assert.Equal(t, "Alice", team.Members[0].Username)
+}
+
+func TestListPullRequests_Pagination(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+
+	teamID, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+	require.NoError(t, s.AddUser(ctx, models.User{
+		UserID:   "u1",
+		TeamID:   teamID,
+		IsActive: true,
+	}))
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"pr-1", "pr-2", "pr-3"} {
+		createdAt = createdAt.Add(time.Hour)
+		require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        id,
+			Name:      id,
+			AuthorID:  "u1",
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: createdAt,
+		}))
+	}
+
+	filter := models.PullRequestFilter{
+		SortBy:     models.PULLREQUEST_SORT_CREATED_AT,
+		Descending: true,
+		Limit:      2,
+	}
+
+	page, err := s.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "pr-3", page[0].ID)
+	assert.Equal(t, "pr-2", page[1].ID)
+
+	filter.After = &models.PullRequestCursor{
+		SortValue: page[1].CreatedAt,
+		ID:        page[1].ID,
+	}
+
+	page, err = s.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "pr-1", page[0].ID)
+}
+
+func TestListPullRequests_UnmergedLast(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+
+	teamID, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+	require.NoError(t, s.AddUser(ctx, models.User{
+		UserID:   "u1",
+		TeamID:   teamID,
+		IsActive: true,
+	}))
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"pr-1", "pr-2", "pr-3", "pr-4"} {
+		require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        id,
+			Name:      id,
+			AuthorID:  "u1",
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: createdAt,
+		}))
+	}
+	require.NoError(t, s.MergePullRequest(ctx, "pr-2", createdAt.Add(2*time.Hour)))
+	require.NoError(t, s.MergePullRequest(ctx, "pr-3", createdAt.Add(time.Hour)))
+
+	// Несмердженные PR идут в конце при любом направлении
+	for descending, want := range map[bool][]string{
+		false: {"pr-3", "pr-2", "pr-1", "pr-4"},
+		true:  {"pr-2", "pr-3", "pr-4", "pr-1"},
+	} {
+		filter := models.PullRequestFilter{
+			SortBy:     models.PULLREQUEST_SORT_MERGED_AT,
+			Descending: descending,
+			Limit:      len(want),
+		}
+
+		page, err := s.ListPullRequests(ctx, filter)
+		require.NoError(t, err)
+		ids := make([]string, len(page))
+		for i := range page {
+			ids[i] = page[i].ID
+		}
+		require.Equal(t, want, ids)
+
+		// Страница после смердженного и после несмердженного PR
+		for i := 1; i < len(want); i++ {
+			filter.After = &models.PullRequestCursor{ID: page[i].ID}
+			if page[i].Status == models.PULLREQUEST_MERGED {
+				filter.After.SortValue = page[i].MergedAt
+			}
+
+			next, err := s.ListPullRequests(ctx, filter)
+			require.NoError(t, err)
+			ids := make([]string, len(next))
+			for j := range next {
+				ids[j] = next[j].ID
+			}
+			assert.Equal(t, want[i+1:], ids)
+		}
+	}
+}
+
+func TestAddReviewers(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+
+	teamID, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+	for _, userID := range []string{"u1", "u2"} {
+		require.NoError(t, s.AddUser(ctx, models.User{
+			UserID:   userID,
+			TeamID:   teamID,
+			IsActive: true,
+		}))
+	}
+	require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+		ID:        "pr-1",
+		AuthorID:  "u1",
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now(),
+	}))
+
+	// Ревьюверы назначаются все или никто
+	err = s.AddReviewers(ctx, "pr-1", []string{"u2", "u404"}, models.ASSIGNMENT_IMPORT)
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+	err = s.AddReviewers(ctx, "pr-1", []string{"u1"}, models.ASSIGNMENT_IMPORT)
+	require.ErrorIs(t, err, repositories.ErrAuthorReviewer)
+
+	require.NoError(t, s.AddReviewers(ctx, "pr-1", []string{"u2"}, models.ASSIGNMENT_IMPORT))
+	err = s.AddReviewers(ctx, "pr-1", []string{"u2"}, models.ASSIGNMENT_IMPORT)
+	require.ErrorIs(t, err, repositories.ErrReviewerAssigned)
+
+	pullRequest, err := s.GetPullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pullRequest.AssignedReviewers)
+}
@@ -0,0 +1,301 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет PR
+func (s *Storage) CreatePullRequest(
+	ctx context.Context,
+	pullRequest models.PullRequest,
+) error {
+	const op = "repositories.memory.CreatePullRequest"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[pullRequest.AuthorID]; !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+	if _, ok := s.data.pullRequests[pullRequest.ID]; ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrPRExists)
+	}
+
+	s.data.lastPRSeq++
+	s.data.pullRequests[pullRequest.ID] = pullRequestRow{
+		seq:       s.data.lastPRSeq,
+		name:      pullRequest.Name,
+		authorID:  pullRequest.AuthorID,
+		status:    pullRequest.Status,
+		createdAt: pullRequest.CreatedAt,
+		mergedAt:  pullRequest.MergedAt,
+	}
+
+	return nil
+}
+
+// Получает PR
+func (s *Storage) GetPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "repositories.memory.GetPullRequest"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.pullRequests[pullRequestID]
+	if !ok {
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return s.data.pullRequest(pullRequestID, row), nil
+}
+
+// Получает PR в которые данный пользователь назначен ревьювером
+func (s *Storage) GetReview(
+	ctx context.Context,
+	userID string,
+) ([]models.PullRequest, error) {
+	const op = "repositories.memory.GetReview"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[userID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	var pullRequests []models.PullRequest
+	for _, r := range s.data.reviewers {
+		if r.userID != userID || !r.unassignedAt.IsZero() {
+			continue
+		}
+
+		row := s.data.pullRequests[r.pullRequestID]
+		pullRequests = append(pullRequests, models.PullRequest{
+			ID:       r.pullRequestID,
+			Name:     row.name,
+			AuthorID: row.authorID,
+			Status:   row.status,
+		})
+	}
+
+	return pullRequests, nil
+}
+
+// Помечает PR как MERGED
+func (s *Storage) MergePullRequest(
+	ctx context.Context,
+	pullRequestID string,
+	mergedAt time.Time,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Как и в БД, несуществующий PR не считается ошибкой
+	row, ok := s.data.pullRequests[pullRequestID]
+	if !ok {
+		return nil
+	}
+
+	row.status = models.PULLREQUEST_MERGED
+	row.mergedAt = mergedAt
+	s.data.pullRequests[pullRequestID] = row
+
+	return nil
+}
+
+// Меняет статус PR
+func (s *Storage) SetStatus(
+	ctx context.Context,
+	pullRequestID string,
+	status models.PRStatus,
+) error {
+	const op = "repositories.memory.SetStatus"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.pullRequests[pullRequestID]
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	row.status = status
+	s.data.pullRequests[pullRequestID] = row
+
+	return nil
+}
+
+// Получает страницу PR, подходящих под фильтр
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter models.PullRequestFilter,
+) ([]models.PullRequest, error) {
+	const op = "repositories.memory.ListPullRequests"
+
+	// У несмердженных PR значение поля сортировки пустое
+	sortValue, ok := map[models.PRSortField]func(pr *pullRequestRow) time.Time{
+		models.PULLREQUEST_SORT_CREATED_AT: func(pr *pullRequestRow) time.Time { return pr.createdAt },
+		models.PULLREQUEST_SORT_MERGED_AT: func(pr *pullRequestRow) time.Time {
+			if pr.status != models.PULLREQUEST_MERGED {
+				return time.Time{}
+			}
+			return pr.mergedAt
+		},
+	}[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown sort field %q", op, filter.SortBy)
+	}
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Сравнивает PR по полю сортировки, затем по ID. PR с пустым значением
+	// поля идут в конце при любом направлении, как NULLS LAST
+	compare := func(aValue time.Time, aID string, bValue time.Time, bID string) int {
+		if aValue.IsZero() != bValue.IsZero() {
+			if aValue.IsZero() {
+				return 1
+			}
+			return -1
+		}
+
+		c := aValue.Compare(bValue)
+		if c == 0 {
+			c = cmp.Compare(aID, bID)
+		}
+		if filter.Descending {
+			c = -c
+		}
+		return c
+	}
+
+	ids := make([]string, 0)
+	for id, pr := range s.data.pullRequests {
+		if !s.data.matches(id, &pr, &filter) {
+			continue
+		}
+
+		// Страница начинается строго после курсора
+		if filter.After != nil && compare(sortValue(&pr), id, filter.After.SortValue, filter.After.ID) <= 0 {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	slices.SortFunc(ids, func(a, b string) int {
+		aRow, bRow := s.data.pullRequests[a], s.data.pullRequests[b]
+		return compare(sortValue(&aRow), a, sortValue(&bRow), b)
+	})
+	if len(ids) > filter.Limit {
+		ids = ids[:filter.Limit]
+	}
+
+	pullRequests := make([]models.PullRequest, 0, len(ids))
+	for _, id := range ids {
+		pullRequest := s.data.pullRequest(id, s.data.pullRequests[id])
+
+		// Ревьюверы упорядочены по ID, как в выборке из БД
+		slices.Sort(pullRequest.AssignedReviewers)
+		slices.SortFunc(pullRequest.Reviews, func(a, b models.Review) int {
+			return cmp.Compare(a.ReviewerID, b.ReviewerID)
+		})
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	return pullRequests, nil
+}
+
+// Подходит ли PR под фильтр
+func (s *state) matches(id string, pr *pullRequestRow, filter *models.PullRequestFilter) bool {
+	if filter.AuthorID != "" && pr.authorID != filter.AuthorID {
+		return false
+	}
+	if filter.ReviewerID != "" && !slices.Contains(s.currentReviewers(id), filter.ReviewerID) {
+		return false
+	}
+	if filter.TeamName != "" && s.teamName(pr.authorID) != filter.TeamName {
+		return false
+	}
+	if filter.Status != "" && pr.status != filter.Status {
+		return false
+	}
+	if !filter.CreatedFrom.IsZero() && pr.createdAt.Before(filter.CreatedFrom) {
+		return false
+	}
+	if !filter.CreatedTo.IsZero() && !pr.createdAt.Before(filter.CreatedTo) {
+		return false
+	}
+	if !filter.MergedFrom.IsZero() && pr.mergedAt.Before(filter.MergedFrom) {
+		return false
+	}
+	// У несмердженных PR merged_at хранит нулевое время
+	if !filter.MergedTo.IsZero() &&
+		(pr.status != models.PULLREQUEST_MERGED || !pr.mergedAt.Before(filter.MergedTo)) {
+		return false
+	}
+
+	return true
+}
+
+// Собирает модель PR из строки таблицы вместе с текущими ревьюверами
+func (s *state) pullRequest(id string, row pullRequestRow) models.PullRequest {
+	pullRequest := models.PullRequest{
+		ID:                id,
+		Name:              row.name,
+		AuthorID:          row.authorID,
+		Status:            row.status,
+		AssignedReviewers: []string{},
+		Reviews:           []models.Review{},
+		CreatedAt:         row.createdAt,
+		MergedAt:          row.mergedAt,
+	}
+
+	for _, r := range s.reviewers {
+		if r.pullRequestID != id || !r.unassignedAt.IsZero() {
+			continue
+		}
+
+		pullRequest.AssignedReviewers = append(pullRequest.AssignedReviewers, r.userID)
+
+		// Ревьювер ещё не оставил вердикт
+		if r.verdict != "" {
+			pullRequest.Reviews = append(pullRequest.Reviews, models.Review{
+				ReviewerID: r.userID,
+				Verdict:    r.verdict,
+			})
+		}
+	}
+
+	pullRequest.ReviewersMissing = repositories.ReviewersMissing(&pullRequest, s.reviewersCount(row.authorID))
+
+	return pullRequest
+}
+
+// Количество ревьюверов, заданное командой автора
+func (s *state) reviewersCount(authorID string) int {
+	return s.teams[s.users[authorID].teamID].reviewersCount
+}
+
+// ID текущих ревьюверов PR
+func (s *state) currentReviewers(pullRequestID string) []string {
+	reviewers := make([]string, 0)
+	for _, r := range s.reviewers {
+		if r.pullRequestID == pullRequestID && r.unassignedAt.IsZero() {
+			reviewers = append(reviewers, r.userID)
+		}
+	}
+
+	return reviewers
+}
@@ -0,0 +1,272 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Назначает наблюдателей на пул реквест, пока их количество
+// не достигнет заданного командой автора. Возвращает ID назначенных
+func (s *Storage) AssignReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	authorID string,
+	reason models.AssignmentReason,
+) ([]string, error) {
+	const op = "repositories.memory.AssignReviewers"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[authorID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+	if _, ok := s.data.pullRequests[pullRequestID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	// Сколько ревьюверов не хватает до количества, заданного командой автора
+	limit := max(s.data.reviewersCount(authorID)-len(s.data.currentReviewers(pullRequestID)), 0)
+	if limit == 0 {
+		return []string{}, nil
+	}
+
+	now := time.Now()
+	teamID := s.data.users[authorID].teamID
+	candidates := s.data.candidates(pullRequestID, teamID, authorID, now, s.strategy)
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	for _, reviewerID := range candidates {
+		s.data.reviewers = append(s.data.reviewers, reviewerRow{
+			pullRequestID: pullRequestID,
+			userID:        reviewerID,
+			reason:        reason,
+			assignedAt:    now.Truncate(time.Second),
+		})
+	}
+
+	return candidates, nil
+}
+
+// Назначает на пул реквест заданных ревьюверов, не проверяя их
+// команду, активность и нагрузку
+func (s *Storage) AddReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerIDs []string,
+	reason models.AssignmentReason,
+) error {
+	const op = "repositories.memory.AddReviewers"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	pr, ok := s.data.pullRequests[pullRequestID]
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	// Проверяем всех ревьюверов до вставки, как ограничения таблицы
+	current := s.data.currentReviewers(pullRequestID)
+	for i, reviewerID := range reviewerIDs {
+		if _, ok := s.data.users[reviewerID]; !ok {
+			return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		if reviewerID == pr.authorID {
+			return fmt.Errorf("%s: %w", op, repositories.ErrAuthorReviewer)
+		}
+		if slices.Contains(current, reviewerID) || slices.Contains(reviewerIDs[:i], reviewerID) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrReviewerAssigned)
+		}
+	}
+
+	assignedAt := time.Now().Truncate(time.Second)
+	for _, reviewerID := range reviewerIDs {
+		s.data.reviewers = append(s.data.reviewers, reviewerRow{
+			pullRequestID: pullRequestID,
+			userID:        reviewerID,
+			reason:        reason,
+			assignedAt:    assignedAt,
+		})
+	}
+
+	return nil
+}
+
+// Переназначает наблюдателя на пул реквест. Старый ревьювер
+// снимается, но остаётся в истории назначений. Транзакции выполняются
+// по очереди, поэтому skipLocked не нужен
+func (s *Storage) ReassignReviewer(
+	ctx context.Context,
+	pullRequestID string,
+	oldReviewerID string,
+	reason models.AssignmentReason,
+	skipLocked bool,
+) (string, error) {
+	const op = "repositories.memory.ReassignReviewer"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	pr, ok := s.data.pullRequests[pullRequestID]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+	oldReviewer, ok := s.data.users[oldReviewerID]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+	if !slices.Contains(s.data.currentReviewers(pullRequestID), oldReviewerID) {
+		return "", fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	// Новый ревьювер выбирается из команды старого
+	now := time.Now()
+	candidates := s.data.candidates(pullRequestID, oldReviewer.teamID, pr.authorID, now, s.strategy)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("%s: %w", op, repositories.ErrNoCandidates)
+	}
+	newReviewerID := candidates[0]
+
+	// Снимаем старого ревьювера, его вердикт остаётся в истории
+	for i := range s.data.reviewers {
+		r := &s.data.reviewers[i]
+		if r.pullRequestID == pullRequestID && r.userID == oldReviewerID && r.unassignedAt.IsZero() {
+			r.unassignedAt = now.Truncate(time.Second)
+		}
+	}
+
+	// Назначаем нового ревьювера
+	s.data.reviewers = append(s.data.reviewers, reviewerRow{
+		pullRequestID: pullRequestID,
+		userID:        newReviewerID,
+		reason:        reason,
+		assignedAt:    now.Truncate(time.Second),
+	})
+
+	return newReviewerID, nil
+}
+
+// Сохраняет вердикт ревьювера пул реквеста
+func (s *Storage) SetVerdict(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerID string,
+	verdict models.ReviewVerdict,
+) error {
+	const op = "repositories.memory.SetVerdict"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	updated := false
+	for i := range s.data.reviewers {
+		r := &s.data.reviewers[i]
+		if r.pullRequestID == pullRequestID && r.userID == reviewerID && r.unassignedAt.IsZero() {
+			r.verdict = verdict
+			updated = true
+		}
+	}
+
+	// Ревьювер не назначен на этот пул реквест
+	if !updated {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает историю назначений ревьюверов пул реквеста в порядке назначения
+func (s *Storage) GetReviewerHistory(
+	ctx context.Context,
+	pullRequestID string,
+) ([]models.ReviewerAssignment, error) {
+	const op = "repositories.memory.GetReviewerHistory"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.pullRequests[pullRequestID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	// Назначения хранятся в порядке назначения
+	history := make([]models.ReviewerAssignment, 0)
+	for _, r := range s.data.reviewers {
+		if r.pullRequestID != pullRequestID {
+			continue
+		}
+
+		history = append(history, models.ReviewerAssignment{
+			ReviewerID:   r.userID,
+			Reason:       r.reason,
+			Verdict:      r.verdict,
+			AssignedAt:   r.assignedAt,
+			UnassignedAt: r.unassignedAt,
+		})
+	}
+
+	return history, nil
+}
+
+// Подходящие ревьюверы PR в порядке стратегии выбора: активные члены
+// команды teamID, кроме автора и уже назначенных, не отсутствующие
+// в момент now и не загруженные до предела
+func (s *state) candidates(
+	pullRequestID string,
+	teamID int64,
+	authorID string,
+	now time.Time,
+	strategy string,
+) []string {
+	assigned := s.currentReviewers(pullRequestID)
+
+	candidates := make([]string, 0)
+	for _, userID := range s.teamMembers(teamID) {
+		row := s.users[userID]
+		if userID == authorID ||
+			!row.isActive ||
+			slices.Contains(assigned, userID) ||
+			s.isAbsent(userID, now) ||
+			(row.maxOpenReviews != nil && s.openReviews(userID) >= *row.maxOpenReviews) {
+			continue
+		}
+
+		candidates = append(candidates, userID)
+	}
+
+	// При равной загрузке кандидаты выбираются случайно
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if strategy == repositories.STRATEGY_LEAST_LOADED {
+		slices.SortStableFunc(candidates, func(a, b string) int {
+			return cmp.Compare(s.openReviews(a), s.openReviews(b))
+		})
+	}
+
+	return candidates
+}
+
+// Количество текущих назначений пользователя на открытые PR
+func (s *state) openReviews(userID string) int {
+	count := 0
+	for _, r := range s.reviewers {
+		if r.userID == userID &&
+			r.unassignedAt.IsZero() &&
+			s.pullRequests[r.pullRequestID].status == models.PULLREQUEST_OPEN {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Получает статистику пользователя
+func (s *Storage) GetUserStats(
+	ctx context.Context,
+	userID string,
+	from time.Time,
+) (models.UserStats, error) {
+	const op = "repositories.memory.GetUserStats"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[userID]; !ok {
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return s.data.userStats(userID, from), nil
+}
+
+// Получает статистику членов команды, упорядоченную для рейтинга:
+// сначала по ревью смердженных PR, затем по всем назначениям
+func (s *Storage) GetTeamUserStats(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+) ([]models.UserStats, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	stats := make([]models.UserStats, 0)
+	if row, ok := s.data.teamByName(teamName); ok {
+		for _, userID := range s.data.teamMembers(row.id) {
+			stats = append(stats, s.data.userStats(userID, from))
+		}
+	}
+
+	slices.SortFunc(stats, func(a, b models.UserStats) int {
+		if c := cmp.Compare(b.MergedReviews, a.MergedReviews); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.ReviewsAssigned, a.ReviewsAssigned); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	return stats, nil
+}
+
+// Считает статистику пользователя по назначениям и PR,
+// начиная с from (нулевое время - за всё время)
+func (s *state) userStats(userID string, from time.Time) models.UserStats {
+	row := s.users[userID]
+	stats := models.UserStats{
+		UserID:   userID,
+		Username: row.username,
+		TeamName: s.teams[row.teamID].name,
+	}
+
+	mergeSeconds := make([]float64, 0)
+	for _, r := range s.reviewers {
+		if r.userID != userID || r.assignedAt.Before(from) {
+			continue
+		}
+
+		stats.ReviewsAssigned++
+		if !r.unassignedAt.IsZero() {
+			stats.ReassignedAway++
+			continue
+		}
+
+		pr := s.pullRequests[r.pullRequestID]
+		switch pr.status {
+		case models.PULLREQUEST_OPEN:
+			stats.OpenReviews++
+		case models.PULLREQUEST_MERGED:
+			stats.MergedReviews++
+			mergeSeconds = append(mergeSeconds, pr.mergedAt.Sub(r.assignedAt).Seconds())
+		}
+	}
+
+	for _, pr := range s.pullRequests {
+		if pr.authorID == userID && !pr.createdAt.Before(from) {
+			stats.PullRequestsAuthored++
+		}
+	}
+
+	if median, ok := repositories.Percentile(mergeSeconds, 0.5); ok {
+		stats.MedianTimeToMerge = repositories.SecondsToDuration(median)
+	}
+
+	return stats
+}
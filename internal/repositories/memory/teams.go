@@ -0,0 +1,181 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Вносит команду и возвращает её ID
+func (s *Storage) AddTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) (int64, error) {
+	const op = "repositories.memory.AddTeam"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.teamByName(teamName); ok {
+		return 0, fmt.Errorf("%s: %w", op, repositories.ErrTeamExists)
+	}
+
+	s.data.lastTeamID++
+	s.data.teams[s.data.lastTeamID] = teamRow{
+		id:             s.data.lastTeamID,
+		name:           teamName,
+		reviewersCount: reviewersCount,
+	}
+
+	return s.data.lastTeamID, nil
+}
+
+// Получает команду по её названию
+func (s *Storage) GetTeam(
+	ctx context.Context,
+	teamName string,
+) (models.Team, error) {
+	const op = "repositories.memory.GetTeam"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.teamByName(teamName)
+	if !ok {
+		return models.Team{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	members := make([]models.User, 0, 8)
+	for _, userID := range s.data.teamMembers(row.id) {
+		member := s.data.user(userID, s.data.users[userID])
+		members = append(members, models.User{
+			UserID:         member.UserID,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			MaxOpenReviews: member.MaxOpenReviews,
+		})
+	}
+
+	return models.Team{
+		TeamName:       teamName,
+		ReviewersCount: row.reviewersCount,
+		Members:        members,
+	}, nil
+}
+
+// Деактивирует пользователей команды
+func (s *Storage) DeactivateTeam(
+	ctx context.Context,
+	teamName string,
+) error {
+	const op = "repositories.memory.DeactivateTeam"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.teamByName(teamName)
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	for _, userID := range s.data.teamMembers(row.id) {
+		member := s.data.users[userID]
+		member.isActive = false
+		s.data.users[userID] = member
+	}
+
+	return nil
+}
+
+// Обновляет настройки команды
+func (s *Storage) UpdateTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) error {
+	const op = "repositories.memory.UpdateTeam"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.teamByName(teamName)
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	row.reviewersCount = reviewersCount
+	s.data.teams[row.id] = row
+
+	return nil
+}
+
+// Получает количество пул реквестов команды по статусам
+func (s *Storage) GetTeamsPullRequests(
+	ctx context.Context,
+	teamName string,
+) (map[models.PRStatus]int, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Статусы без пул реквестов в результат не попадут
+	statuses := make(map[models.PRStatus]int)
+	for _, pr := range s.data.pullRequests {
+		if s.data.teamName(pr.authorID) == teamName {
+			statuses[pr.status]++
+		}
+	}
+
+	return statuses, nil
+}
+
+// Получает количество открытых пул реквестов каждой команды
+func (s *Storage) GetOpenPullRequestsByTeam(
+	ctx context.Context,
+) (map[string]int, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Команды без открытых пул реквестов попадут в результат с нулём
+	counts := make(map[string]int, len(s.data.teams))
+	for _, row := range s.data.teams {
+		counts[row.name] = 0
+	}
+	for _, pr := range s.data.pullRequests {
+		if pr.status == models.PULLREQUEST_OPEN {
+			counts[s.data.teamName(pr.authorID)]++
+		}
+	}
+
+	return counts, nil
+}
+
+func (s *state) teamByName(teamName string) (teamRow, bool) {
+	for _, row := range s.teams {
+		if row.name == teamName {
+			return row, true
+		}
+	}
+
+	return teamRow{}, false
+}
+
+// ID членов команды в порядке добавления
+func (s *state) teamMembers(teamID int64) []string {
+	members := make([]string, 0)
+	for userID, row := range s.users {
+		if row.teamID == teamID {
+			members = append(members, userID)
+		}
+	}
+
+	slices.SortFunc(members, func(a, b string) int {
+		return cmp.Compare(s.users[a].seq, s.users[b].seq)
+	})
+
+	return members
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Получает перцентили времени до мерджа PR команды, смердженных в [from, to)
+func (s *Storage) GetMergeTimes(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+) (models.MergeTimes, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	seconds := make([]float64, 0)
+	for _, pr := range s.data.pullRequests {
+		if s.data.teamName(pr.authorID) == teamName &&
+			pr.status == models.PULLREQUEST_MERGED &&
+			!pr.mergedAt.Before(from) && pr.mergedAt.Before(to) {
+			seconds = append(seconds, pr.mergedAt.Sub(pr.createdAt).Seconds())
+		}
+	}
+
+	mergeTimes := models.MergeTimes{
+		MergedPullRequests: len(seconds),
+	}
+
+	// Без смердженных PR перцентилей нет
+	if p50, ok := repositories.Percentile(seconds, 0.5); ok {
+		p90, _ := repositories.Percentile(seconds, 0.9)
+		p99, _ := repositories.Percentile(seconds, 0.99)
+
+		mergeTimes.P50 = repositories.SecondsToDuration(p50)
+		mergeTimes.P90 = repositories.SecondsToDuration(p90)
+		mergeTimes.P99 = repositories.SecondsToDuration(p99)
+	}
+
+	return mergeTimes, nil
+}
+
+// Считает открытые PR команды, созданные в [from, to), по корзинам возраста
+// на момент now. Корзин на одну больше чем границ bounds
+func (s *Storage) GetOpenPullRequestAges(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	now time.Time,
+	bounds []time.Duration,
+) ([]int, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	counts := make([]int, len(bounds)+1)
+	for _, pr := range s.data.openPullRequests(teamName, from, to) {
+		// Номер корзины - количество границ, не превышающих возраст
+		age := now.Sub(pr.createdAt)
+		bucket := 0
+		for bucket < len(bounds) && bounds[bucket] <= age {
+			bucket++
+		}
+
+		counts[bucket]++
+	}
+
+	return counts, nil
+}
+
+// Получает самые старые открытые PR команды, созданные в [from, to)
+func (s *Storage) GetOldestOpenPullRequests(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	limit int,
+) ([]models.PullRequest, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	pullRequests := make([]models.PullRequest, 0)
+	for id, pr := range s.data.openPullRequests(teamName, from, to) {
+		pullRequests = append(pullRequests, models.PullRequest{
+			ID:        id,
+			Name:      pr.name,
+			AuthorID:  pr.authorID,
+			Status:    pr.status,
+			CreatedAt: pr.createdAt,
+		})
+	}
+
+	slices.SortFunc(pullRequests, func(a, b models.PullRequest) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if len(pullRequests) > limit {
+		pullRequests = pullRequests[:limit]
+	}
+
+	return pullRequests, nil
+}
+
+// Все PR и так хранятся в памяти, поэтому время до мерджа
+// не сворачивается и всегда считается по самим PR
+func (s *Storage) RollupMergeTimes(
+	ctx context.Context,
+	until time.Time,
+) error {
+	return nil
+}
+
+// Открытые PR команды, созданные в [from, to), по их ID
+func (s *state) openPullRequests(teamName string, from time.Time, to time.Time) map[string]pullRequestRow {
+	pullRequests := make(map[string]pullRequestRow)
+	for id, pr := range s.pullRequests {
+		if s.teamName(pr.authorID) == teamName &&
+			pr.status == models.PULLREQUEST_OPEN &&
+			!pr.createdAt.Before(from) && pr.createdAt.Before(to) {
+			pullRequests[id] = pr
+		}
+	}
+
+	return pullRequests
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет токен доступа по хешу и возвращает его ID
+func (s *Storage) AddToken(
+	ctx context.Context,
+	apiToken models.APIToken,
+	tokenHash string,
+) (int64, error) {
+	const op = "repositories.memory.AddToken"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	for _, t := range s.data.tokens {
+		if t.hash == tokenHash {
+			return 0, fmt.Errorf("%s: token hash already exists", op)
+		}
+	}
+
+	apiToken.ID = int64(len(s.data.tokens) + 1)
+	apiToken.RevokedAt = time.Time{}
+	s.data.tokens = append(s.data.tokens, tokenRow{
+		APIToken: apiToken,
+		hash:     tokenHash,
+	})
+
+	return apiToken.ID, nil
+}
+
+// Возвращает неотозванный токен по его хешу
+func (s *Storage) GetTokenByHash(
+	ctx context.Context,
+	tokenHash string,
+) (models.APIToken, error) {
+	const op = "repositories.memory.GetTokenByHash"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	for _, t := range s.data.tokens {
+		if t.hash == tokenHash && t.RevokedAt.IsZero() {
+			return t.APIToken, nil
+		}
+	}
+
+	return models.APIToken{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+}
+
+// Возвращает все токены, включая отозванные
+func (s *Storage) ListTokens(
+	ctx context.Context,
+) ([]models.APIToken, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	tokens := make([]models.APIToken, 0, len(s.data.tokens))
+	for _, t := range s.data.tokens {
+		tokens = append(tokens, t.APIToken)
+	}
+
+	return tokens, nil
+}
+
+// Отзывает токен. Повторный отзыв не меняет время отзыва
+func (s *Storage) RevokeToken(
+	ctx context.Context,
+	tokenID int64,
+	revokedAt time.Time,
+) error {
+	const op = "repositories.memory.RevokeToken"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Токен не найден
+	if tokenID < 1 || tokenID > int64(len(s.data.tokens)) {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	t := &s.data.tokens[tokenID-1]
+	if t.RevokedAt.IsZero() {
+		t.RevokedAt = revokedAt
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+)
+
+// Менеджер транзакций хранилища в памяти. Транзакции выполняются
+// по очереди, при ошибке или панике данные возвращаются к снимку,
+// снятому в начале транзакции
+type TxManager struct {
+	s *Storage
+}
+
+func NewTxManager(s *Storage) *TxManager {
+	return &TxManager{
+		s: s,
+	}
+}
+
+// Выполняет f в транзакции. Вложенный вызов выполняется
+// в уже открытой транзакции
+func (m *TxManager) Do(
+	ctx context.Context,
+	f func(context.Context) error,
+) (err error) {
+	if m.s.inTx(ctx) {
+		return f(ctx)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.s.txMu.Lock()
+	defer m.s.txMu.Unlock()
+
+	m.s.mu.Lock()
+	snapshot := m.s.data.clone()
+	m.s.mu.Unlock()
+
+	rollback := func() {
+		m.s.mu.Lock()
+		m.s.data = snapshot
+		m.s.mu.Unlock()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			rollback()
+			panic(r)
+		}
+	}()
+
+	err = f(context.WithValue(ctx, txKey{}, m.s))
+	if err != nil {
+		rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет пользователя, либо обновляет его
+func (s *Storage) AddUser(
+	ctx context.Context,
+	user models.User,
+) error {
+	const op = "repositories.memory.AddUser"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.teams[user.TeamID]; !ok {
+		return fmt.Errorf("%s: team %d does not exist", op, user.TeamID)
+	}
+
+	// Обновлённый пользователь остаётся на своём месте в команде
+	row, ok := s.data.users[user.UserID]
+	if !ok {
+		s.data.lastUserSeq++
+		row.seq = s.data.lastUserSeq
+	}
+
+	row.username = user.Username
+	row.teamID = user.TeamID
+	row.isActive = user.IsActive
+	row.maxOpenReviews = copyInt(user.MaxOpenReviews)
+	s.data.users[user.UserID] = row
+
+	return nil
+}
+
+// Возвращает пользователя по его ID
+func (s *Storage) GetUser(
+	ctx context.Context,
+	userID string,
+) (models.User, error) {
+	const op = "repositories.memory.GetUser"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.users[userID]
+	if !ok {
+		return models.User{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return s.data.user(userID, row), nil
+}
+
+// Меняет is_active у пользователя
+func (s *Storage) SetActive(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+) error {
+	const op = "repositories.memory.SetActive"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.users[userID]
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	row.isActive = isActive
+	s.data.users[userID] = row
+
+	return nil
+}
+
+// Меняет max_open_reviews у пользователя
+func (s *Storage) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) error {
+	const op = "repositories.memory.SetMaxOpenReviews"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	row, ok := s.data.users[userID]
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	row.maxOpenReviews = copyInt(maxOpenReviews)
+	s.data.users[userID] = row
+
+	return nil
+}
+
+// Собирает модель пользователя из строки таблицы
+func (s *state) user(userID string, row userRow) models.User {
+	return models.User{
+		UserID:         userID,
+		Username:       row.username,
+		TeamID:         row.teamID,
+		TeamName:       s.teams[row.teamID].name,
+		IsActive:       row.isActive,
+		MaxOpenReviews: copyInt(row.maxOpenReviews),
+	}
+}
+
+// Команда пользователя (пустая строка - пользователь не найден)
+func (s *state) teamName(userID string) string {
+	row, ok := s.users[userID]
+	if !ok {
+		return ""
+	}
+
+	return s.teams[row.teamID].name
+}
+
+// Указатели копируются, чтобы изменения снаружи не попадали в хранилище
+func copyInt(v *int) *int {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+	return &c
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет, либо обновляет соответствие логина пользователю
+func (s *Storage) SetVCSLogin(
+	ctx context.Context,
+	login models.VCSLogin,
+) error {
+	const op = "repositories.memory.SetVCSLogin"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.users[login.UserID]; !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	s.data.vcsLogins[vcsLoginKey{login.Provider, login.Login}] = login.UserID
+
+	return nil
+}
+
+// Возвращает все соответствия логинов пользователям
+func (s *Storage) GetVCSLogins(
+	ctx context.Context,
+) ([]models.VCSLogin, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	logins := make([]models.VCSLogin, 0, len(s.data.vcsLogins))
+	for key, userID := range s.data.vcsLogins {
+		logins = append(logins, models.VCSLogin{
+			Provider: key.provider,
+			Login:    key.login,
+			UserID:   userID,
+		})
+	}
+
+	slices.SortFunc(logins, func(a, b models.VCSLogin) int {
+		if c := cmp.Compare(a.Provider, b.Provider); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Login, b.Login)
+	})
+
+	return logins, nil
+}
+
+// Возвращает ID пользователя по логину в системе контроля версий
+func (s *Storage) GetUserIDByVCSLogin(
+	ctx context.Context,
+	provider models.VCSProvider,
+	login string,
+) (string, error) {
+	const op = "repositories.memory.GetUserIDByVCSLogin"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	userID, ok := s.data.vcsLogins[vcsLoginKey{provider, login}]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return userID, nil
+}
+
+// Удаляет соответствие логина пользователю
+func (s *Storage) DeleteVCSLogin(
+	ctx context.Context,
+	provider models.VCSProvider,
+	login string,
+) error {
+	const op = "repositories.memory.DeleteVCSLogin"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	key := vcsLoginKey{provider, login}
+	if _, ok := s.data.vcsLogins[key]; !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	delete(s.data.vcsLogins, key)
+
+	return nil
+}
+
+// Возвращает логины пользователей в системе контроля версий.
+// Пользователи без сопоставленного логина пропускаются
+func (s *Storage) GetVCSLoginsByUserIDs(
+	ctx context.Context,
+	provider models.VCSProvider,
+	userIDs []string,
+) ([]string, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	logins := make([]string, 0, len(userIDs))
+	for key, userID := range s.data.vcsLogins {
+		if key.provider == provider && slices.Contains(userIDs, userID) {
+			logins = append(logins, key.login)
+		}
+	}
+
+	slices.Sort(logins)
+
+	return logins, nil
+}
@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Кладёт задачу синхронизации в очередь. Вызывается в транзакции
+// изменения, поэтому задача сохраняется только вместе с ним
+func (s *Storage) EnqueueVCSSyncJob(
+	ctx context.Context,
+	job models.VCSSyncJob,
+	nextAttemptAt time.Time,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	job.ID = int64(len(s.data.vcsSyncJobs) + 1)
+	job.RequestLogins = slices.Clone(job.RequestLogins)
+	job.RemoveLogins = slices.Clone(job.RemoveLogins)
+	job.Attempts = 0
+	s.data.vcsSyncJobs = append(s.data.vcsSyncJobs, vcsSyncJob{
+		VCSSyncJob: job,
+		attempt: attempt{
+			nextAttemptAt: nextAttemptAt,
+		},
+	})
+
+	return nil
+}
+
+// Забирает готовые к выполнению задачи и блокирует их до lockedUntil
+func (s *Storage) ClaimVCSSyncJobs(
+	ctx context.Context,
+	now time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.VCSSyncJob, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	claimed := claim(s.data.vcsSyncJobs, now, lockedUntil, limit, func(j *vcsSyncJob) *attempt {
+		return &j.attempt
+	})
+
+	jobs := make([]models.VCSSyncJob, 0, len(claimed))
+	for _, i := range claimed {
+		job := s.data.vcsSyncJobs[i].VCSSyncJob
+		job.RequestLogins = slices.Clone(job.RequestLogins)
+		job.RemoveLogins = slices.Clone(job.RemoveLogins)
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// Помечает задачу выполненной
+func (s *Storage) CompleteVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	doneAt time.Time,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if job := s.data.vcsSyncJob(jobID); job != nil {
+		job.Attempts++
+		job.complete(doneAt)
+	}
+
+	return nil
+}
+
+// Откладывает задачу до следующей попытки
+func (s *Storage) RetryVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	nextAttemptAt time.Time,
+	lastError string,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if job := s.data.vcsSyncJob(jobID); job != nil {
+		job.Attempts++
+		job.retry(nextAttemptAt, lastError)
+	}
+
+	return nil
+}
+
+// Прекращает попытки выполнить задачу
+func (s *Storage) FailVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	failedAt time.Time,
+	lastError string,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if job := s.data.vcsSyncJob(jobID); job != nil {
+		job.Attempts++
+		job.fail(failedAt, lastError)
+	}
+
+	return nil
+}
+
+// Задача по ID (nil - не найдена)
+func (s *state) vcsSyncJob(jobID int64) *vcsSyncJob {
+	if jobID < 1 || jobID > int64(len(s.vcsSyncJobs)) {
+		return nil
+	}
+
+	return &s.vcsSyncJobs[jobID-1]
+}
+
+// Блокирует до lockedUntil не больше limit готовых элементов очереди,
+// начиная с тех, чья попытка назначена раньше, и возвращает их номера
+func claim[T any](queue []T, now time.Time, lockedUntil time.Time, limit int, attemptOf func(*T) *attempt) []int {
+	ready := make([]int, 0)
+	for i := range queue {
+		if attemptOf(&queue[i]).ready(now) {
+			ready = append(ready, i)
+		}
+	}
+
+	// Номера идут по возрастанию ID, поэтому сортировка устойчивая
+	slices.SortStableFunc(ready, func(a, b int) int {
+		return attemptOf(&queue[a]).nextAttemptAt.Compare(attemptOf(&queue[b]).nextAttemptAt)
+	})
+	if len(ready) > limit {
+		ready = ready[:limit]
+	}
+
+	for _, i := range ready {
+		attemptOf(&queue[i]).lockedUntil = lockedUntil
+	}
+
+	return ready
+}
+
+func (a *attempt) complete(doneAt time.Time) {
+	a.doneAt = doneAt
+	a.lockedUntil = time.Time{}
+	a.lastError = ""
+}
+
+func (a *attempt) retry(nextAttemptAt time.Time, lastError string) {
+	a.nextAttemptAt = nextAttemptAt
+	a.lockedUntil = time.Time{}
+	a.lastError = lastError
+}
+
+func (a *attempt) fail(failedAt time.Time, lastError string) {
+	a.failedAt = failedAt
+	a.lockedUntil = time.Time{}
+	a.lastError = lastError
+}
@@ -0,0 +1,254 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет подписку на события и возвращает её ID
+func (s *Storage) AddWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) (int64, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	s.data.lastWebhookID++
+	webhook.ID = s.data.lastWebhookID
+	webhook.Events = slices.Clone(webhook.Events)
+	s.data.webhooks[webhook.ID] = webhook
+
+	return webhook.ID, nil
+}
+
+// Возвращает подписку по её ID
+func (s *Storage) GetWebhook(
+	ctx context.Context,
+	webhookID int64,
+) (models.Webhook, error) {
+	const op = "repositories.memory.GetWebhook"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	webhook, ok := s.data.webhooks[webhookID]
+	if !ok {
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	webhook.Events = slices.Clone(webhook.Events)
+	return webhook, nil
+}
+
+// Возвращает все подписки
+func (s *Storage) ListWebhooks(
+	ctx context.Context,
+) ([]models.Webhook, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	webhooks := make([]models.Webhook, 0, len(s.data.webhooks))
+	for _, webhook := range s.data.webhooks {
+		webhook.Events = slices.Clone(webhook.Events)
+		webhooks = append(webhooks, webhook)
+	}
+
+	slices.SortFunc(webhooks, func(a, b models.Webhook) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return webhooks, nil
+}
+
+// Обновляет подписку
+func (s *Storage) UpdateWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) error {
+	const op = "repositories.memory.UpdateWebhook"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	stored, ok := s.data.webhooks[webhook.ID]
+	if !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	stored.URL = webhook.URL
+	stored.Secret = webhook.Secret
+	stored.Events = slices.Clone(webhook.Events)
+	stored.IsActive = webhook.IsActive
+	s.data.webhooks[webhook.ID] = stored
+
+	return nil
+}
+
+// Удаляет подписку вместе с её недоставленными событиями
+func (s *Storage) DeleteWebhook(
+	ctx context.Context,
+	webhookID int64,
+) error {
+	const op = "repositories.memory.DeleteWebhook"
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if _, ok := s.data.webhooks[webhookID]; !ok {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	delete(s.data.webhooks, webhookID)
+	s.data.deliveries = slices.DeleteFunc(s.data.deliveries, func(d delivery) bool {
+		return d.webhookID == webhookID
+	})
+
+	return nil
+}
+
+// Кладёт событие в исходящую очередь для каждой подходящей подписки.
+// Вызывается в транзакции изменения, поэтому событие сохраняется
+// только вместе с ним
+func (s *Storage) PublishEvent(
+	ctx context.Context,
+	event models.Event,
+) error {
+	const op = "repositories.memory.PublishEvent"
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	// Подписки перебираются по ID, чтобы доставки шли в порядке подписок
+	webhookIDs := make([]int64, 0, len(s.data.webhooks))
+	for id, webhook := range s.data.webhooks {
+		if webhook.IsActive && (len(webhook.Events) == 0 || slices.Contains(webhook.Events, event.Type)) {
+			webhookIDs = append(webhookIDs, id)
+		}
+	}
+	slices.Sort(webhookIDs)
+
+	for _, webhookID := range webhookIDs {
+		s.data.lastDeliveryID++
+		s.data.deliveries = append(s.data.deliveries, delivery{
+			WebhookDelivery: models.WebhookDelivery{
+				ID:      s.data.lastDeliveryID,
+				Event:   event.Type,
+				Payload: payload,
+			},
+			webhookID: webhookID,
+			attempt: attempt{
+				nextAttemptAt: event.OccurredAt,
+			},
+		})
+	}
+
+	return nil
+}
+
+// Забирает готовые к отправке доставки и блокирует их до lockedUntil
+func (s *Storage) ClaimDeliveries(
+	ctx context.Context,
+	now time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.WebhookDelivery, error) {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	claimed := claim(s.data.deliveries, now, lockedUntil, limit, func(d *delivery) *attempt {
+		return &d.attempt
+	})
+
+	deliveries := make([]models.WebhookDelivery, 0, len(claimed))
+	for _, i := range claimed {
+		d := s.data.deliveries[i]
+		webhook := s.data.webhooks[d.webhookID]
+
+		deliveries = append(deliveries, models.WebhookDelivery{
+			ID:       d.ID,
+			Event:    d.Event,
+			Payload:  slices.Clone(d.Payload),
+			Attempts: d.Attempts,
+			URL:      webhook.URL,
+			Secret:   webhook.Secret,
+		})
+	}
+
+	return deliveries, nil
+}
+
+// Помечает доставку выполненной
+func (s *Storage) MarkDelivered(
+	ctx context.Context,
+	deliveryID int64,
+	deliveredAt time.Time,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if d := s.data.delivery(deliveryID); d != nil {
+		d.Attempts++
+		d.complete(deliveredAt)
+	}
+
+	return nil
+}
+
+// Откладывает доставку до следующей попытки
+func (s *Storage) RetryDelivery(
+	ctx context.Context,
+	deliveryID int64,
+	nextAttemptAt time.Time,
+	lastError string,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if d := s.data.delivery(deliveryID); d != nil {
+		d.Attempts++
+		d.retry(nextAttemptAt, lastError)
+	}
+
+	return nil
+}
+
+// Прекращает попытки доставки
+func (s *Storage) FailDelivery(
+	ctx context.Context,
+	deliveryID int64,
+	failedAt time.Time,
+	lastError string,
+) error {
+	unlock := s.lock(ctx)
+	defer unlock()
+
+	if d := s.data.delivery(deliveryID); d != nil {
+		d.Attempts++
+		d.fail(failedAt, lastError)
+	}
+
+	return nil
+}
+
+// Доставка по ID (nil - не найдена или удалена вместе с подпиской)
+func (s *state) delivery(deliveryID int64) *delivery {
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == deliveryID {
+			return &s.deliveries[i]
+		}
+	}
+
+	return nil
+}
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 type Storage struct {
-	pool   *pgxpool.Pool
-	getter *trmpgx.CtxGetter
+	pool     *pgxpool.Pool
+	getter   *trmpgx.CtxGetter
+	strategy SelectionStrategy
 }
 
 func New(
@@ -22,6 +24,8 @@ func New(
 	dbName string,
 	maxConns int32,
 	getter *trmpgx.CtxGetter,
+	strategy SelectionStrategy,
+	tracer pgx.QueryTracer,
 ) (*Storage, error) {
 	const op = "repositories.postgres.New"
 
@@ -34,6 +38,8 @@ func New(
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	// Трассировка запросов (nil - без трассировки)
+	config.ConnConfig.Tracer = tracer
 
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
@@ -41,8 +47,9 @@ func New(
 	}
 
 	return &Storage{
-		pool:   pool,
-		getter: getter,
+		pool:     pool,
+		getter:   getter,
+		strategy: strategy,
 	}, nil
 }
 
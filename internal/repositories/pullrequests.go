@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
@@ -20,24 +21,20 @@ func (s *Storage) CreatePullRequest(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID автора
-	id, err := s.getUserID(ctx, pullRequest.AuthorID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	// Вставляем ID пул реквеста
-	insertID := conn.QueryRow(
+	// Вставляем пул реквест, если автор существует
+	tag, err := conn.Exec(
 		ctx,
-		"INSERT INTO pull_requests_id (pull_request_id) VALUES ($1) RETURNING id",
-		pullRequest.ID,
+		`
+		INSERT INTO pull_requests (
+			pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+		)
+		SELECT $1, $2, id, $4, $5, $6
+		FROM users
+		WHERE user_id = $3;
+		`,
+		pullRequest.ID, pullRequest.Name, pullRequest.AuthorID,
+		pullRequest.Status, pullRequest.CreatedAt, pullRequest.MergedAt,
 	)
-
-	var prID int64
-	err = insertID.Scan(&prID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == UNIQUE_VIOLATION_CODE {
@@ -46,19 +43,9 @@ func (s *Storage) CreatePullRequest(
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Вставляем пул реквест
-	_, err = conn.Exec(
-		ctx,
-		`
-		INSERT INTO pull_requests (
-			pull_request_id, pull_request_name, author_id, status, created_at, merged_at
-		) 
-		VALUES ($1, $2, $3, $4, $5, $6);
-		`,
-		prID, pullRequest.Name, id, pullRequest.Status, pullRequest.CreatedAt, pullRequest.MergedAt,
-	)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	// Автор не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
 
 	return nil
@@ -73,14 +60,26 @@ func (s *Storage) GetPullRequest(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем пул реквест
+	// Получаем пул реквест, ревьюверы и их вердикты
+	// собираются в массивы в том же запросе
 	getPR := conn.QueryRow(
 		ctx,
 		`
-		SELECT p.id, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at 
+		SELECT 
+			p.pull_request_name, a.user_id, p.status, p.created_at, 
+			p.merged_at, t.reviewers_count, rv.reviewers, rv.verdicts
 		FROM pull_requests p 
-		JOIN pull_requests_id i ON p.pull_request_id = i.id
-		WHERE i.pull_request_id = $1;
+		JOIN users a ON p.author_id = a.id
+		JOIN teams t ON a.team_id = t.id
+		LEFT JOIN LATERAL (
+			SELECT 
+				COALESCE(array_agg(u.user_id ORDER BY u.user_id), '{}') AS reviewers,
+				COALESCE(array_agg(r.verdict ORDER BY u.user_id), '{}') AS verdicts
+			FROM reviewers r
+			JOIN users u ON r.user_id = u.id
+			WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+		) rv ON TRUE
+		WHERE p.pull_request_id = $1;
 		`,
 		pullRequestID,
 	)
@@ -88,15 +87,18 @@ func (s *Storage) GetPullRequest(
 	pullRequest := models.PullRequest{
 		ID: pullRequestID,
 	}
-	var author, prID int64
+	var reviewersCount int
+	var verdicts []*string
 
 	err := getPR.Scan(
-		&prID,
 		&pullRequest.Name,
-		&author,
+		&pullRequest.AuthorID,
 		&pullRequest.Status,
 		&pullRequest.CreatedAt,
 		&pullRequest.MergedAt,
+		&reviewersCount,
+		&pullRequest.AssignedReviewers,
+		&verdicts,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -105,53 +107,20 @@ func (s *Storage) GetPullRequest(
 		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Получаем ID автора
-	getAuthorID := conn.QueryRow(
-		ctx,
-		`
-		SELECT i.user_id 
-		FROM users u
-		JOIN users_id i ON u.user_id = i.id
-		WHERE u.id = $1;
-		`,
-		author,
-	)
-
-	err = getAuthorID.Scan(&pullRequest.AuthorID)
-	if err != nil {
-		// Нет смысла проверять на ErrNoRows
-		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
-	}
-
-	// Получаем ревьюверов
-	getReviewers, err := conn.Query(
-		ctx,
-		`
-		SELECT i.user_id 
-		FROM reviewers r
-		JOIN users u ON r.user_id = u.id
-		JOIN users_id i ON u.user_id = i.id
-		WHERE pull_request_id = $1;
-		`,
-		prID,
-	)
-	if err != nil {
-		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
-	}
-	defer getReviewers.Close()
-
-	// Если ревьюверы не нашлись, то значит их и нет
-	pullRequest.AssignedReviewers = []string{}
-	for getReviewers.Next() {
-		var reviewer string
-		err := getReviewers.Scan(&reviewer)
-		if err != nil {
-			return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	// Массивы ревьюверов и вердиктов упорядочены одинаково,
+	// ревьювер без вердикта его ещё не оставил
+	pullRequest.Reviews = []models.Review{}
+	for i, verdict := range verdicts {
+		if verdict != nil {
+			pullRequest.Reviews = append(pullRequest.Reviews, models.Review{
+				ReviewerID: pullRequest.AssignedReviewers[i],
+				Verdict:    *verdict,
+			})
 		}
-
-		pullRequest.AssignedReviewers = append(pullRequest.AssignedReviewers, reviewer)
 	}
 
+	pullRequest.ReviewersMissing = ReviewersMissing(&pullRequest, reviewersCount)
+
 	return pullRequest, nil
 }
 
@@ -164,28 +133,20 @@ func (s *Storage) GetReview(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID юзера
-	id, err := s.getUserID(ctx, userID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	// Получаем все пул реквесты в которых данный юзер ревьювер
+	// Получаем пользователя и все пул реквесты в которых он ревьювер,
+	// у пользователя без них будет одна строка с пустыми полями PR
 	getPullRequests, err := conn.Query(
 		ctx,
 		`
-		SELECT ip.pull_request_id, p.pull_request_name, iu.user_id, p.status
-		FROM reviewers r
-		JOIN pull_requests p ON r.pull_request_id = p.id
-		JOIN pull_requests_id ip ON ip.id = p.pull_request_id
-		JOIN users u ON p.author_id = u.id
-		JOIN users_id iu ON u.user_id = iu.id
-		WHERE r.user_id = $1;
+		SELECT p.pull_request_id, p.pull_request_name, a.user_id, p.status
+		FROM users u
+		LEFT JOIN reviewers r ON r.user_id = u.id AND r.unassigned_at IS NULL
+		LEFT JOIN pull_requests p ON r.pull_request_id = p.id
+		LEFT JOIN users a ON p.author_id = a.id
+		WHERE u.user_id = $1
+		ORDER BY r.id;
 		`,
-		id,
+		userID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -193,20 +154,35 @@ func (s *Storage) GetReview(
 	defer getPullRequests.Close()
 
 	// Читаем строчки
+	found := false
 	var pullRequests []models.PullRequest
 	for getPullRequests.Next() {
-		var pullRequest models.PullRequest
-		err := getPullRequests.Scan(
-			&pullRequest.ID,
-			&pullRequest.Name,
-			&pullRequest.AuthorID,
-			&pullRequest.Status,
-		)
+		var id, name, authorID, status *string
+		err := getPullRequests.Scan(&id, &name, &authorID, &status)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		found = true
 
-		pullRequests = append(pullRequests, pullRequest)
+		// Пользователь не назначен ни на один пул реквест
+		if id == nil {
+			continue
+		}
+
+		pullRequests = append(pullRequests, models.PullRequest{
+			ID:       *id,
+			Name:     *name,
+			AuthorID: *authorID,
+			Status:   *status,
+		})
+	}
+	if err := getPullRequests.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if !found {
+		return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
 
 	return pullRequests, nil
@@ -228,11 +204,7 @@ func (s *Storage) MergePullRequest(
 		`
 		UPDATE pull_requests 
 		SET status = $1, merged_at = $2 
-		WHERE pull_request_id = (
-			SELECT id 
-			FROM pull_requests_id 
-			WHERE pull_request_id = $3
-		);
+		WHERE pull_request_id = $3;
 		`,
 		models.PULLREQUEST_MERGED, mergedAt, pullRequestID,
 	)
@@ -245,3 +217,215 @@ func (s *Storage) MergePullRequest(
 
 	return nil
 }
+
+// Меняет статус PR
+func (s *Storage) SetStatus(
+	ctx context.Context,
+	pullRequestID string,
+	status models.PRStatus,
+) error {
+	const op = "repositories.postgres.SetStatus"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Обновляем статус пул реквеста
+	tag, err := conn.Exec(
+		ctx,
+		`
+		UPDATE pull_requests 
+		SET status = $1
+		WHERE pull_request_id = $2;
+		`,
+		status, pullRequestID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пул реквест не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает страницу PR, подходящих под фильтр, одним запросом
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter models.PullRequestFilter,
+) ([]models.PullRequest, error) {
+	const op = "repositories.postgres.ListPullRequests"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	conditions := []string{"TRUE"}
+	args := []any{}
+
+	// Поле сортировки подставляется в запрос, поэтому берём его только из списка.
+	// У несмердженных PR merged_at хранит нулевое время, поэтому для сортировки
+	// оно заменяется на NULL, и такие PR идут в конце списка
+	var sortColumn string
+	switch filter.SortBy {
+	case models.PULLREQUEST_SORT_CREATED_AT:
+		sortColumn = "p.created_at"
+	case models.PULLREQUEST_SORT_MERGED_AT:
+		args = append(args, models.PULLREQUEST_MERGED)
+		sortColumn = fmt.Sprintf("(CASE WHEN p.status = $%d THEN p.merged_at END)", len(args))
+	default:
+		return nil, fmt.Errorf("%s: unknown sort field %q", op, filter.SortBy)
+	}
+
+	// Собираем условия фильтра
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.AuthorID != "" {
+		addCondition("a.user_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		addCondition(
+			`
+			EXISTS (
+				SELECT 1
+				FROM reviewers fr
+				JOIN users fu ON fr.user_id = fu.id
+				WHERE fr.pull_request_id = p.id AND fr.unassigned_at IS NULL AND fu.user_id = $%d
+			)
+			`,
+			filter.ReviewerID,
+		)
+	}
+	if filter.TeamName != "" {
+		addCondition("t.team_name = $%d", filter.TeamName)
+	}
+	if filter.Status != "" {
+		addCondition("p.status = $%d", filter.Status)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		addCondition("p.created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCondition("p.created_at < $%d", filter.CreatedTo)
+	}
+	if !filter.MergedFrom.IsZero() {
+		addCondition("p.merged_at >= $%d", filter.MergedFrom)
+	}
+	if !filter.MergedTo.IsZero() {
+		// У несмердженных PR merged_at хранит нулевое время
+		addCondition("p.status = $%d", models.PULLREQUEST_MERGED)
+		addCondition("p.merged_at < $%d", filter.MergedTo)
+	}
+
+	// Страница начинается строго после курсора
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	switch {
+	case filter.After == nil:
+	// После несмердженного PR идут только несмердженные
+	case filter.After.SortValue.IsZero():
+		args = append(args, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf(
+			"%s IS NULL AND p.pull_request_id %s $%d",
+			sortColumn, comparison, len(args),
+		))
+	default:
+		args = append(args, filter.After.SortValue, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf(
+			"((%s, p.pull_request_id) %s ($%d, $%d) OR %s IS NULL)",
+			sortColumn, comparison, len(args)-1, len(args), sortColumn,
+		))
+	}
+
+	args = append(args, filter.Limit)
+
+	// Ревьюверы и их вердикты собираются в массивы в том же запросе
+	getPullRequests, err := conn.Query(
+		ctx,
+		fmt.Sprintf(
+			`
+			SELECT 
+				p.pull_request_id, p.pull_request_name, a.user_id, p.status, 
+				p.created_at, p.merged_at, t.reviewers_count, rv.reviewers, rv.verdicts
+			FROM pull_requests p
+			JOIN users a ON p.author_id = a.id
+			JOIN teams t ON a.team_id = t.id
+			LEFT JOIN LATERAL (
+				SELECT 
+					COALESCE(array_agg(u.user_id ORDER BY u.user_id), '{}') AS reviewers,
+					COALESCE(array_agg(r.verdict ORDER BY u.user_id), '{}') AS verdicts
+				FROM reviewers r
+				JOIN users u ON r.user_id = u.id
+				WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+			) rv ON TRUE
+			WHERE %s
+			ORDER BY %s %s NULLS LAST, p.pull_request_id %s
+			LIMIT $%d;
+			`,
+			strings.Join(conditions, " AND "),
+			sortColumn, direction, direction,
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPullRequests.Close()
+
+	// Читаем строчки
+	pullRequests := make([]models.PullRequest, 0, filter.Limit)
+	for getPullRequests.Next() {
+		var pullRequest models.PullRequest
+		var reviewersCount int
+		var verdicts []*string
+		err := getPullRequests.Scan(
+			&pullRequest.ID,
+			&pullRequest.Name,
+			&pullRequest.AuthorID,
+			&pullRequest.Status,
+			&pullRequest.CreatedAt,
+			&pullRequest.MergedAt,
+			&reviewersCount,
+			&pullRequest.AssignedReviewers,
+			&verdicts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Массивы ревьюверов и вердиктов упорядочены одинаково
+		pullRequest.Reviews = []models.Review{}
+		for i, verdict := range verdicts {
+			if verdict != nil {
+				pullRequest.Reviews = append(pullRequest.Reviews, models.Review{
+					ReviewerID: pullRequest.AssignedReviewers[i],
+					Verdict:    *verdict,
+				})
+			}
+		}
+
+		pullRequest.ReviewersMissing = ReviewersMissing(&pullRequest, reviewersCount)
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+	if err := getPullRequests.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// Считает, сколько ревьюверов не хватает открытому пул реквесту
+// до количества, заданного командой автора
+func ReviewersMissing(pullRequest *models.PullRequest, reviewersCount int) int {
+	if pullRequest.Status != models.PULLREQUEST_OPEN {
+		return 0
+	}
+
+	return max(reviewersCount-len(pullRequest.AssignedReviewers), 0)
+}
@@ -0,0 +1,443 @@
+// Общие проверки реализаций хранилища, которые запускаются
+// в тестах каждого драйвера
+package repotest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/metrics"
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/service/auth"
+	"github.com/iskanye/avito-tech-internship/internal/service/integrations"
+	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/iskanye/avito-tech-internship/internal/service/vcssync"
+	"github.com/iskanye/avito-tech-internship/internal/service/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Хранилище, методы которого проверяются
+type Storage interface {
+	prassignment.UserCreator
+	prassignment.UserModifier
+	prassignment.UserProvider
+	prassignment.UserStatistics
+	prassignment.TeamCreator
+	prassignment.TeamProvider
+	prassignment.TeamModifier
+	prassignment.TeamStatistics
+	prassignment.PRCreator
+	prassignment.PRModifier
+	prassignment.PRProvider
+	prassignment.ReviewersAssigner
+	prassignment.ReviewersModifier
+	prassignment.AbsenceCreator
+	prassignment.AbsenceProvider
+	prassignment.AbsenceModifier
+	prassignment.AuditCreator
+	prassignment.AuditProvider
+	prassignment.EventPublisher
+
+	webhooks.WebhookCreator
+	webhooks.WebhookProvider
+	webhooks.WebhookModifier
+	webhooks.DeliveryQueue
+
+	integrations.VCSLoginProvider
+	integrations.VCSLoginModifier
+
+	auth.TokenCreator
+	auth.TokenProvider
+	auth.TokenModifier
+
+	vcssync.JobQueue
+	vcssync.LoginProvider
+
+	metrics.OpenPullRequestsProvider
+}
+
+// Максимальное количество запросов одного метода хранилища
+const MAX_QUERIES = 1
+
+// Откатывает транзакцию проверки, чтобы она не оставляла данных
+var errRollback = errors.New("rollback")
+
+// Вызывает каждый метод хранилища в одной транзакции и проверяет, что
+// он сделал не больше MAX_QUERIES запросов. queries возвращает количество
+// запросов, выполненных хранилищем с момента создания. limits задаёт
+// другие пределы для методов, которым одного запроса недостаточно.
+// Транзакция откатывается, поэтому проверку можно запускать на общей БД
+func CheckQueryCounts(
+	t *testing.T,
+	txManager prassignment.TransactionManager,
+	storage Storage,
+	queries func() int64,
+	limits map[string]int,
+) {
+	t.Helper()
+
+	err := txManager.Do(context.Background(), func(ctx context.Context) error {
+		check := func(method string, call func() error) {
+			t.Helper()
+
+			before := queries()
+			err := call()
+			count := queries() - before
+
+			require.NoError(t, err, method)
+
+			limit, ok := limits[method]
+			if !ok {
+				limit = MAX_QUERIES
+			}
+			assert.LessOrEqual(t, count, int64(limit), "%s: too many queries", method)
+		}
+
+		callMethods(t, ctx, storage, check)
+
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+}
+
+// Вызывает методы хранилища на данных, которые они сами и создают
+func callMethods(
+	t *testing.T,
+	ctx context.Context,
+	s Storage,
+	check func(method string, call func() error),
+) {
+	t.Helper()
+
+	// Уникальные имена позволяют не мешать данным общей БД
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	teamName := "queries-" + suffix
+	userID := func(name string) string {
+		return name + "-" + suffix
+	}
+	author, reviewer := userID("author"), userID("reviewer")
+	pullRequestID := userID("pr")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	today := now.Truncate(24 * time.Hour)
+	month := now.AddDate(0, -1, 0)
+
+	// Команда и пользователи
+
+	var teamID int64
+	check("AddTeam", func() (err error) {
+		teamID, err = s.AddTeam(ctx, teamName, 2)
+		return err
+	})
+	for _, id := range []string{author, reviewer, userID("r2"), userID("r3")} {
+		check("AddUser", func() error {
+			return s.AddUser(ctx, models.User{
+				UserID:   id,
+				Username: id,
+				TeamID:   teamID,
+				IsActive: true,
+			})
+		})
+	}
+	check("GetUser", func() error {
+		_, err := s.GetUser(ctx, author)
+		return err
+	})
+	check("SetActive", func() error {
+		return s.SetActive(ctx, author, true)
+	})
+	check("SetMaxOpenReviews", func() error {
+		return s.SetMaxOpenReviews(ctx, reviewer, nil)
+	})
+	check("GetTeam", func() error {
+		_, err := s.GetTeam(ctx, teamName)
+		return err
+	})
+	check("UpdateTeam", func() error {
+		return s.UpdateTeam(ctx, teamName, 2)
+	})
+
+	// Пул реквесты и ревью
+
+	check("CreatePullRequest", func() error {
+		return s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        pullRequestID,
+			Name:      pullRequestID,
+			AuthorID:  author,
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: now,
+		})
+	})
+	var reviewers []string
+	check("AssignReviewers", func() (err error) {
+		reviewers, err = s.AssignReviewers(ctx, pullRequestID, author, models.ASSIGNMENT_INITIAL)
+		return err
+	})
+	require.NotEmpty(t, reviewers)
+	check("GetPullRequest", func() error {
+		_, err := s.GetPullRequest(ctx, pullRequestID)
+		return err
+	})
+	check("GetReview", func() error {
+		_, err := s.GetReview(ctx, reviewers[0])
+		return err
+	})
+	check("SetVerdict", func() error {
+		return s.SetVerdict(ctx, pullRequestID, reviewers[0], models.REVIEW_APPROVED)
+	})
+	check("ReassignReviewer", func() error {
+		_, err := s.ReassignReviewer(ctx, pullRequestID, reviewers[0], models.ASSIGNMENT_MANUAL_REASSIGN, false)
+		return err
+	})
+	check("GetReviewerHistory", func() error {
+		_, err := s.GetReviewerHistory(ctx, pullRequestID)
+		return err
+	})
+	importedID := userID("imported")
+	check("CreatePullRequest", func() error {
+		return s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        importedID,
+			Name:      importedID,
+			AuthorID:  author,
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: now,
+		})
+	})
+	check("AddReviewers", func() error {
+		return s.AddReviewers(ctx, importedID, []string{reviewer, userID("r2")}, models.ASSIGNMENT_IMPORT)
+	})
+	check("ListPullRequests", func() error {
+		_, err := s.ListPullRequests(ctx, models.PullRequestFilter{
+			TeamName: teamName,
+			SortBy:   models.PULLREQUEST_SORT_CREATED_AT,
+			Limit:    10,
+		})
+		return err
+	})
+	check("SetStatus", func() error {
+		return s.SetStatus(ctx, pullRequestID, models.PULLREQUEST_OPEN)
+	})
+	check("MergePullRequest", func() error {
+		return s.MergePullRequest(ctx, pullRequestID, now)
+	})
+
+	// Статистика
+
+	check("GetUserStats", func() error {
+		_, err := s.GetUserStats(ctx, author, month)
+		return err
+	})
+	check("GetTeamUserStats", func() error {
+		_, err := s.GetTeamUserStats(ctx, teamName, month)
+		return err
+	})
+	check("GetTeamsPullRequests", func() error {
+		_, err := s.GetTeamsPullRequests(ctx, teamName)
+		return err
+	})
+	check("GetOpenPullRequestsByTeam", func() error {
+		_, err := s.GetOpenPullRequestsByTeam(ctx)
+		return err
+	})
+	check("GetMergeTimes", func() error {
+		_, err := s.GetMergeTimes(ctx, teamName, month, now.Add(time.Hour))
+		return err
+	})
+	check("GetOpenPullRequestAges", func() error {
+		_, err := s.GetOpenPullRequestAges(ctx, teamName, month, now, now, []time.Duration{time.Hour})
+		return err
+	})
+	check("GetOldestOpenPullRequests", func() error {
+		_, err := s.GetOldestOpenPullRequests(ctx, teamName, month, now, 5)
+		return err
+	})
+	check("RollupMergeTimes", func() error {
+		return s.RollupMergeTimes(ctx, today)
+	})
+
+	// Отсутствия
+
+	var absenceID int64
+	check("AddAbsence", func() (err error) {
+		absenceID, err = s.AddAbsence(ctx, models.Absence{
+			UserID:   reviewer,
+			StartsAt: now.Add(-time.Hour),
+			EndsAt:   now.Add(time.Hour),
+			Reason:   "vacation",
+		})
+		return err
+	})
+	check("GetAbsences", func() error {
+		_, err := s.GetAbsences(ctx, reviewer)
+		return err
+	})
+	check("GetStartedAbsences", func() error {
+		_, err := s.GetStartedAbsences(ctx, now)
+		return err
+	})
+	check("MarkAbsenceReassigned", func() error {
+		return s.MarkAbsenceReassigned(ctx, absenceID)
+	})
+	check("DeleteAbsence", func() error {
+		return s.DeleteAbsence(ctx, reviewer, absenceID)
+	})
+	check("DeactivateTeam", func() error {
+		return s.DeactivateTeam(ctx, teamName)
+	})
+
+	// Аудит
+
+	check("AddAuditEvent", func() error {
+		return s.AddAuditEvent(ctx, models.AuditEvent{
+			Actor:         "admin",
+			Operation:     models.AUDIT_TEAM_ADD,
+			PullRequestID: pullRequestID,
+			TeamName:      teamName,
+			UserID:        author,
+			After:         json.RawMessage(`{}`),
+			OccurredAt:    now,
+		})
+	})
+	check("ListAuditEvents", func() error {
+		_, err := s.ListAuditEvents(ctx, models.AuditFilter{
+			TeamName: teamName,
+			Limit:    10,
+		})
+		return err
+	})
+
+	// Токены
+
+	var tokenID int64
+	check("AddToken", func() (err error) {
+		tokenID, err = s.AddToken(ctx, models.APIToken{
+			Name:      teamName,
+			Role:      models.ROLE_ADMIN,
+			CreatedAt: now,
+		}, "hash-"+suffix)
+		return err
+	})
+	check("GetTokenByHash", func() error {
+		_, err := s.GetTokenByHash(ctx, "hash-"+suffix)
+		return err
+	})
+	check("ListTokens", func() error {
+		_, err := s.ListTokens(ctx)
+		return err
+	})
+	check("RevokeToken", func() error {
+		return s.RevokeToken(ctx, tokenID, now)
+	})
+
+	// Логины в системах контроля версий
+
+	login := "login-" + suffix
+	check("SetVCSLogin", func() error {
+		return s.SetVCSLogin(ctx, models.VCSLogin{
+			Provider: models.VCS_GITHUB,
+			Login:    login,
+			UserID:   author,
+		})
+	})
+	check("GetVCSLogins", func() error {
+		_, err := s.GetVCSLogins(ctx)
+		return err
+	})
+	check("GetUserIDByVCSLogin", func() error {
+		_, err := s.GetUserIDByVCSLogin(ctx, models.VCS_GITHUB, login)
+		return err
+	})
+	check("GetVCSLoginsByUserIDs", func() error {
+		_, err := s.GetVCSLoginsByUserIDs(ctx, models.VCS_GITHUB, []string{author, reviewer})
+		return err
+	})
+	check("DeleteVCSLogin", func() error {
+		return s.DeleteVCSLogin(ctx, models.VCS_GITHUB, login)
+	})
+
+	// Очередь синхронизации с системами контроля версий
+
+	check("EnqueueVCSSyncJob", func() error {
+		return s.EnqueueVCSSyncJob(ctx, models.VCSSyncJob{
+			Provider:      models.VCS_GITHUB,
+			Repository:    "org/" + teamName,
+			Number:        1,
+			RequestLogins: []string{login},
+		}, now)
+	})
+	var jobs []models.VCSSyncJob
+	check("ClaimVCSSyncJobs", func() (err error) {
+		jobs, err = s.ClaimVCSSyncJobs(ctx, now, now.Add(time.Minute), 100)
+		return err
+	})
+	require.NotEmpty(t, jobs)
+	check("CompleteVCSSyncJob", func() error {
+		return s.CompleteVCSSyncJob(ctx, jobs[0].ID, now)
+	})
+	check("RetryVCSSyncJob", func() error {
+		return s.RetryVCSSyncJob(ctx, jobs[0].ID, now, "failed")
+	})
+	check("FailVCSSyncJob", func() error {
+		return s.FailVCSSyncJob(ctx, jobs[0].ID, now, "failed")
+	})
+
+	// Вебхуки и доставки
+
+	var webhookID int64
+	check("AddWebhook", func() (err error) {
+		webhookID, err = s.AddWebhook(ctx, models.Webhook{
+			URL:      "http://localhost/" + teamName,
+			Secret:   "secret",
+			Events:   []string{string(models.EVENT_PR_CREATED)},
+			IsActive: true,
+		})
+		return err
+	})
+	check("GetWebhook", func() error {
+		_, err := s.GetWebhook(ctx, webhookID)
+		return err
+	})
+	check("ListWebhooks", func() error {
+		_, err := s.ListWebhooks(ctx)
+		return err
+	})
+	check("UpdateWebhook", func() error {
+		return s.UpdateWebhook(ctx, models.Webhook{
+			ID:       webhookID,
+			URL:      "http://localhost/" + teamName,
+			Secret:   "secret",
+			Events:   []string{string(models.EVENT_PR_CREATED)},
+			IsActive: true,
+		})
+	})
+	check("PublishEvent", func() error {
+		return s.PublishEvent(ctx, models.Event{
+			Type:       models.EVENT_PR_CREATED,
+			OccurredAt: now,
+			Data:       map[string]string{"pull_request_id": pullRequestID},
+		})
+	})
+	var deliveries []models.WebhookDelivery
+	check("ClaimDeliveries", func() (err error) {
+		deliveries, err = s.ClaimDeliveries(ctx, now.Add(time.Second), now.Add(time.Minute), 100)
+		return err
+	})
+	require.NotEmpty(t, deliveries)
+	check("MarkDelivered", func() error {
+		return s.MarkDelivered(ctx, deliveries[0].ID, now)
+	})
+	check("RetryDelivery", func() error {
+		return s.RetryDelivery(ctx, deliveries[0].ID, now, "failed")
+	})
+	check("FailDelivery", func() error {
+		return s.FailDelivery(ctx, deliveries[0].ID, now, "failed")
+	})
+	check("DeleteWebhook", func() error {
+		return s.DeleteWebhook(ctx, webhookID)
+	})
+}
@@ -4,205 +4,414 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
-// Назначает наблюдателей на пул реквест
-func (s *Storage) AssignReviewers(
-	ctx context.Context,
-	pullRequestID string,
-	authorID string,
-) error {
-	const op = "repositories.postgres.AssignReviewers"
+// Код ошибки, если значение нарушает CHECK
+const CHECK_VIOLATION_CODE = "23514"
 
-	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+// Ограничения таблицы reviewers
+const (
+	REVIEWERS_CURRENT_KEY = "reviewers_current_key"
+	REVIEWERS_NOT_AUTHOR  = "reviewers_not_author"
+)
 
-	// Получаем ID автора
-	id, err := s.getUserID(ctx, authorID)
-	if err != nil {
-		// Ловить ErrNotFound нет смысла так как
-		// при создании PR уже на это проверялось
-		return fmt.Errorf("%s: %w", op, err)
+// Переводит нарушение ограничений таблицы reviewers в ошибку хранилища,
+// остальные ошибки возвращает как есть
+func reviewersViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
 	}
 
-	// Получаем ID пул реквеста
-	getID := conn.QueryRow(
-		ctx,
+	switch {
+	case pgErr.Code == UNIQUE_VIOLATION_CODE && pgErr.ConstraintName == REVIEWERS_CURRENT_KEY:
+		return ErrReviewerAssigned
+	case pgErr.Code == CHECK_VIOLATION_CODE && pgErr.ConstraintName == REVIEWERS_NOT_AUTHOR:
+		return ErrAuthorReviewer
+	}
+
+	return err
+}
+
+// Условие, исключающее кандидатов, у которых открытых ревью
+// уже столько, сколько им разрешено.
+// Кандидаты выбираются из таблицы users с псевдонимом u
+func underCapacityCondition() string {
+	return fmt.Sprintf(
 		`
-		SELECT p.id 
-		FROM pull_requests p
-		JOIN pull_requests_id i ON p.pull_request_id = i.id
-		WHERE i.pull_request_id = $1;
+		(
+			u.max_open_reviews IS NULL OR
+			(
+				SELECT COUNT(*)
+				FROM reviewers cr
+				JOIN pull_requests cp ON cr.pull_request_id = cp.id
+				WHERE cr.user_id = u.id AND cr.unassigned_at IS NULL AND cp.status = '%s'
+			) < u.max_open_reviews
+		)
 		`,
-		pullRequestID,
+		models.PULLREQUEST_OPEN,
 	)
+}
 
-	var prID int64
-	err = getID.Scan(&prID)
-	if err != nil {
-		// Ловить ErrNotFound нет смысла так как
-		// PR должен быть создан
-		return fmt.Errorf("%s: %w", op, err)
-	}
+// Назначает наблюдателей на пул реквест, пока их количество
+// не достигнет заданного командой автора. Возвращает ID назначенных
+func (s *Storage) AssignReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	authorID string,
+	reason models.AssignmentReason,
+) ([]string, error) {
+	const op = "repositories.postgres.AssignReviewers"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID доступных членов команды
+	// Время назначения хранится с точностью до секунды, как время
+	// создания и мерджа PR, иначе время до мерджа бывает отрицательным
+	now := time.Now()
+	assignedAt := now.Truncate(time.Second)
+
+	// Выбираем доступных членов команды автора, пока ревьюверов не станет
+	// столько, сколько задано командой, и назначаем их в том же запросе.
+	// Отсутствующие сейчас и уже загруженные до предела пользователи
+	// не назначаются. Кандидаты блокируются до конца транзакции, чтобы
+	// одного пользователя не выбрали одновременно сверх его предела.
+	// Заблокированных кандидатов ждём, а не пропускаем: иначе параллельно
+	// создаваемые PR небольшой команды остались бы без ревьюверов
 	getReviewers, err := conn.Query(
 		ctx,
-		`
-		SELECT u.id 
-		FROM users u
-		WHERE 
-			u.id <> $1 AND 
-			u.team_id = (SELECT uu.team_id from users uu WHERE uu.id = $1) AND 
-			u.is_active = TRUE
-		ORDER BY RANDOM()
-		LIMIT 2;
-		`,
-		id,
+		fmt.Sprintf(
+			`
+			WITH
+				pr AS (
+					SELECT 
+						p.id, a.id AS author_id, a.team_id,
+						GREATEST(
+							t.reviewers_count - (
+								SELECT COUNT(*)
+								FROM reviewers r
+								WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+							),
+							0
+						) AS missing
+					FROM pull_requests p
+					JOIN users a ON a.user_id = $2
+					JOIN teams t ON a.team_id = t.id
+					WHERE p.pull_request_id = $1
+				),
+				candidates AS (
+					SELECT u.id, u.user_id
+					FROM users u, pr
+					WHERE 
+						u.id <> pr.author_id AND 
+						u.team_id = pr.team_id AND 
+						u.is_active = TRUE AND
+						u.id NOT IN (
+							SELECT user_id 
+							FROM reviewers
+							WHERE pull_request_id = pr.id AND unassigned_at IS NULL
+						) AND
+						%s AND
+						%s
+					ORDER BY %s
+					LIMIT COALESCE((SELECT missing FROM pr), 0)
+					FOR UPDATE OF u
+				),
+				assigned AS (
+					INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+					SELECT pr.id, c.id, $3, $5
+					FROM pr, candidates c
+				)
+			SELECT user_id FROM candidates;
+			`,
+			notAbsentCondition(4),
+			underCapacityCondition(),
+			s.strategy.OrderBy(),
+		),
+		pullRequestID, authorID, reason, now, assignedAt,
 	)
 	if err != nil {
-		// Если юзеров в команде кроме самого автора нет
-		// то ничего не делаем
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil
-		}
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, reviewersViolation(err))
 	}
 	defer getReviewers.Close()
 
-	reviewers := make([]int64, 0)
+	// Если подходящих юзеров в команде нет, то никого не назначаем
+	reviewerIDs := make([]string, 0)
 	for getReviewers.Next() {
-		var reviewerID int64
+		var reviewerID string
 		err := getReviewers.Scan(&reviewerID)
 		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 
-		reviewers = append(reviewers, reviewerID)
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+	if err := getReviewers.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, reviewersViolation(err))
 	}
 
-	for _, reviewerID := range reviewers {
-		// Назначаем ревьюверов
-		_, err = conn.Exec(
-			ctx,
-			`
-			INSERT INTO reviewers (pull_request_id, user_id)
-			VALUES ($1, $2);
-			`,
-			prID, reviewerID,
-		)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	return reviewerIDs, nil
+}
+
+// Назначает на пул реквест заданных ревьюверов, не проверяя их
+// команду, активность и нагрузку
+func (s *Storage) AddReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerIDs []string,
+	reason models.AssignmentReason,
+) error {
+	const op = "repositories.postgres.AddReviewers"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	assignedAt := time.Now().Truncate(time.Second)
+
+	// Ревьюверы вставляются, только если существуют все
+	tag, err := conn.Exec(
+		ctx,
+		`
+		INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+		SELECT p.id, u.id, $3, $4
+		FROM pull_requests p
+		JOIN users u ON u.user_id = ANY($2)
+		WHERE 
+			p.pull_request_id = $1 AND
+			(SELECT COUNT(*) FROM users WHERE user_id = ANY($2)) = $5;
+		`,
+		pullRequestID, reviewerIDs, reason, assignedAt, len(reviewerIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, reviewersViolation(err))
+	}
+
+	// Пул реквест или кто-то из ревьюверов не найден
+	if tag.RowsAffected() != int64(len(reviewerIDs)) {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
 
 	return nil
 }
 
-// Переназначает наблюдателя на пул реквест
+// Переназначает наблюдателя на пул реквест. Старый ревьювер
+// снимается, но остаётся в истории назначений. С skipLocked кандидаты,
+// заблокированные другими транзакциями, пропускаются, иначе их ждём
 func (s *Storage) ReassignReviewer(
 	ctx context.Context,
 	pullRequestID string,
 	oldReviewerID string,
+	reason models.AssignmentReason,
+	skipLocked bool,
 ) (string, error) {
 	const op = "repositories.postgres.ReassignReviewer"
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID и автора пул реквеста
-	getID := conn.QueryRow(
+	now := time.Now()
+	reassignedAt := now.Truncate(time.Second)
+
+	// Выбираем нового ревьювера из команды старого среди присутствующих
+	// сейчас и не загруженных до предела пользователей. Если он нашёлся,
+	// в том же запросе снимаем старого ревьювера (его вердикт остаётся
+	// в истории) и назначаем нового. Назначение старого ревьювера
+	// блокируется, поэтому параллельная транзакция не заменит его второй
+	// раз. Кандидаты тоже блокируются, чтобы одного пользователя не выбрали
+	// одновременно сверх его предела
+	lockCandidate := "FOR UPDATE OF u"
+	if skipLocked {
+		lockCandidate += " SKIP LOCKED"
+	}
+	reassign := conn.QueryRow(
 		ctx,
-		`
-		SELECT p.id, p.author_id
-		FROM pull_requests p
-		JOIN pull_requests_id i ON p.pull_request_id = i.id
-		WHERE i.pull_request_id = $1
-		`,
-		pullRequestID,
+		fmt.Sprintf(
+			`
+			WITH
+				pr AS (
+					SELECT id, author_id
+					FROM pull_requests
+					WHERE pull_request_id = $1
+				),
+				old AS (
+					SELECT u.id, u.team_id
+					FROM users u
+					JOIN reviewers r ON r.user_id = u.id
+					JOIN pr ON r.pull_request_id = pr.id
+					WHERE u.user_id = $2 AND r.unassigned_at IS NULL
+					FOR UPDATE OF r
+				),
+				candidate AS (
+					SELECT u.id, u.user_id
+					FROM users u, pr, old
+					WHERE 
+						u.is_active = TRUE AND
+						u.team_id = old.team_id AND
+						u.id <> old.id AND
+						u.id <> pr.author_id AND
+						u.id NOT IN (
+							SELECT user_id 
+							FROM reviewers
+							WHERE pull_request_id = pr.id AND unassigned_at IS NULL
+						) AND
+						%s AND
+						%s
+					ORDER BY %s
+					LIMIT 1
+					%s
+				),
+				unassigned AS (
+					UPDATE reviewers r
+					SET unassigned_at = $5
+					FROM pr, old, candidate
+					WHERE r.pull_request_id = pr.id AND r.user_id = old.id AND r.unassigned_at IS NULL
+				),
+				assigned AS (
+					INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+					SELECT pr.id, candidate.id, $3, $5
+					FROM pr, candidate
+				)
+			SELECT
+				EXISTS (SELECT 1 FROM pr) AND EXISTS (SELECT 1 FROM old),
+				(SELECT user_id FROM candidate);
+			`,
+			notAbsentCondition(4),
+			underCapacityCondition(),
+			s.strategy.OrderBy(),
+			lockCandidate,
+		),
+		pullRequestID, oldReviewerID, reason, now, reassignedAt,
 	)
 
-	var prID, authorID int64
-	err := getID.Scan(&prID, &authorID)
+	var found bool
+	var newReviewerID *string
+	err := reassign.Scan(&found, &newReviewerID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return "", fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return "", fmt.Errorf("%s: %w", op, err)
+		return "", fmt.Errorf("%s: %w", op, reviewersViolation(err))
 	}
 
-	// Получаем ID прошлого ревьювера
-	oldReviewer, err := s.getUserID(ctx, oldReviewerID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return "", fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return "", fmt.Errorf("%s: %w", op, err)
+	// Пул реквест не найден или старый ревьювер на него не назначен
+	if !found {
+		return "", fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	// Нету подходящего ревьювера
+	if newReviewerID == nil {
+		return "", fmt.Errorf("%s: %w", op, ErrNoCandidates)
 	}
 
-	// Получаем нового ревьювера
-	getNewReviewer := conn.QueryRow(
+	return *newReviewerID, nil
+}
+
+// Сохраняет вердикт ревьювера пул реквеста
+func (s *Storage) SetVerdict(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerID string,
+	verdict models.ReviewVerdict,
+) error {
+	const op = "repositories.postgres.SetVerdict"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Обновляем вердикт
+	tag, err := conn.Exec(
 		ctx,
 		`
-		SELECT u.id 
-		FROM users u
+		UPDATE reviewers
+		SET verdict = $1
 		WHERE 
-			u.is_active = TRUE AND
-			u.team_id = (
-				SELECT team_id 
-				FROM users 
-				WHERE id = $1
-			) AND
-			u.id <> $2 AND
-			u.id NOT IN (
-				SELECT user_id 
-				FROM reviewers
-				WHERE pull_request_id = $3
-			);
+			pull_request_id = (SELECT id FROM pull_requests WHERE pull_request_id = $2) AND
+			user_id = (SELECT id FROM users WHERE user_id = $3) AND
+			unassigned_at IS NULL;
 		`,
-		oldReviewer, authorID, prID,
+		verdict, pullRequestID, reviewerID,
 	)
-
-	var newReviewer int64
-	err = getNewReviewer.Scan(&newReviewer)
 	if err != nil {
-		// Нету подходящего ревьювера
-		if errors.Is(err, pgx.ErrNoRows) {
-			return "", fmt.Errorf("%s: %w", op, ErrNoCandidates)
-		}
-		return "", fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Обновляем старого ревьювера
-	_, err = conn.Exec(
+	// Ревьювер не назначен на этот пул реквест
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает историю назначений ревьюверов пул реквеста в порядке назначения
+func (s *Storage) GetReviewerHistory(
+	ctx context.Context,
+	pullRequestID string,
+) ([]models.ReviewerAssignment, error) {
+	const op = "repositories.postgres.GetReviewerHistory"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Получаем пул реквест и всех его ревьюверов, в том числе снятых,
+	// у пул реквеста без них будет одна строка с пустыми полями ревьювера
+	getHistory, err := conn.Query(
 		ctx,
 		`
-		UPDATE reviewers 
-		SET user_id = $1
-		WHERE pull_request_id = $2 AND user_id = $3
+		SELECT u.user_id, r.reason, r.verdict, r.assigned_at, r.unassigned_at
+		FROM pull_requests p
+		LEFT JOIN reviewers r ON r.pull_request_id = p.id
+		LEFT JOIN users u ON r.user_id = u.id
+		WHERE p.pull_request_id = $1
+		ORDER BY r.assigned_at, r.id;
 		`,
-		newReviewer, prID, oldReviewer,
+		pullRequestID,
 	)
 	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer getHistory.Close()
 
-	// Получаем ID нового ревьювера
-	getNewReviewerID := conn.QueryRow(
-		ctx,
-		`
-		SELECT i.user_id 
-		FROM users u
-		JOIN users_id i ON u.user_id = i.id 
-		WHERE u.id = $1`,
-		newReviewer,
-	)
+	found := false
+	history := make([]models.ReviewerAssignment, 0)
+	for getHistory.Next() {
+		var reviewerID, reason, verdict *string
+		var assignedAt, unassignedAt *time.Time
+		err := getHistory.Scan(
+			&reviewerID,
+			&reason,
+			&verdict,
+			&assignedAt,
+			&unassignedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		found = true
 
-	var newReviewerID string
-	err = getNewReviewerID.Scan(&newReviewerID)
-	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
+		// Ревьюверы на пул реквест не назначались
+		if reviewerID == nil {
+			continue
+		}
+
+		assignment := models.ReviewerAssignment{
+			ReviewerID: *reviewerID,
+			Reason:     *reason,
+			AssignedAt: *assignedAt,
+		}
+		if verdict != nil {
+			assignment.Verdict = *verdict
+		}
+		if unassignedAt != nil {
+			assignment.UnassignedAt = *unassignedAt
+		}
+
+		history = append(history, assignment)
+	}
+	if err := getHistory.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пул реквест не найден
+	if !found {
+		return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
 
-	return newReviewerID, nil
+	return history, nil
 }
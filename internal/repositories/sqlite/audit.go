@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Добавляет запись в журнал изменений. Вызывается в транзакции
+// изменения, поэтому запись сохраняется только вместе с ним
+func (s *Storage) AddAuditEvent(
+	ctx context.Context,
+	event models.AuditEvent,
+) error {
+	const op = "repositories.sqlite.AddAuditEvent"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO audit_events (
+			actor, operation, pull_request_id, team_name, user_id,
+			before, after, request_id, occurred_at
+		)
+		VALUES (
+			$1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''),
+			$6, $7, NULLIF($8, ''), $9
+		);
+		`,
+		event.Actor, event.Operation, event.PullRequestID, event.TeamName, event.UserID,
+		jsonOrNull(event.Before), jsonOrNull(event.After), event.RequestID, timestamp(event.OccurredAt),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Получает страницу журнала изменений от новых записей к старым
+func (s *Storage) ListAuditEvents(
+	ctx context.Context,
+	filter models.AuditFilter,
+) ([]models.AuditEvent, error) {
+	const op = "repositories.sqlite.ListAuditEvents"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Собираем условия фильтра
+	conditions := []string{"TRUE"}
+	args := []any{}
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.PullRequestID != "" {
+		addCondition("pull_request_id = $%d", filter.PullRequestID)
+	}
+	if filter.TeamName != "" {
+		addCondition("team_name = $%d", filter.TeamName)
+	}
+	if filter.UserID != "" {
+		addCondition("user_id = $%d", filter.UserID)
+	}
+	if !filter.From.IsZero() {
+		addCondition("occurred_at >= $%d", timestamp(filter.From))
+	}
+	if !filter.To.IsZero() {
+		addCondition("occurred_at < $%d", timestamp(filter.To))
+	}
+	// Страница начинается строго после курсора
+	if filter.After != nil {
+		addCondition("id < $%d", filter.After.ID)
+	}
+
+	args = append(args, filter.Limit)
+
+	rows, err := conn.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			SELECT 
+				id, actor, operation, COALESCE(pull_request_id, ''), COALESCE(team_name, ''),
+				COALESCE(user_id, ''), before, after, COALESCE(request_id, ''), occurred_at
+			FROM audit_events
+			WHERE %s
+			ORDER BY id DESC
+			LIMIT $%d;
+			`,
+			strings.Join(conditions, " AND "),
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	events := make([]models.AuditEvent, 0)
+	for rows.Next() {
+		var event models.AuditEvent
+		var before, after []byte
+		err := rows.Scan(
+			&event.ID,
+			&event.Actor,
+			&event.Operation,
+			&event.PullRequestID,
+			&event.TeamName,
+			&event.UserID,
+			&before,
+			&after,
+			&event.RequestID,
+			&event.OccurredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		event.Before, event.After = before, after
+
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return events, nil
+}
+
+// Пустой снимок сохраняется как NULL
+func jsonOrNull(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return string(data)
+}
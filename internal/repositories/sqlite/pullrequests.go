@@ -0,0 +1,452 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет PR в базу данных
+func (s *Storage) CreatePullRequest(
+	ctx context.Context,
+	pullRequest models.PullRequest,
+) error {
+	const op = "repositories.sqlite.CreatePullRequest"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Вставляем пул реквест, если автор существует
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO pull_requests (
+			pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+		)
+		SELECT $1, $2, id, $4, $5, $6
+		FROM users
+		WHERE user_id = $3;
+		`,
+		pullRequest.ID, pullRequest.Name, pullRequest.AuthorID, pullRequest.Status,
+		timestamp(pullRequest.CreatedAt), timestamp(pullRequest.MergedAt),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrPRExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Автор не найден
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает PR из базы данных
+func (s *Storage) GetPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "repositories.sqlite.GetPullRequest"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Получаем пул реквест, ревьюверы и их вердикты
+	// собираются в JSON массивы в том же запросе
+	getPR := conn.QueryRowContext(
+		ctx,
+		`
+		SELECT 
+			p.pull_request_name, a.user_id, p.status, p.created_at, 
+			p.merged_at, t.reviewers_count,
+			(
+				SELECT json_group_array(u.user_id ORDER BY u.user_id)
+				FROM reviewers r
+				JOIN users u ON r.user_id = u.id
+				WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+			),
+			(
+				SELECT json_group_array(r.verdict ORDER BY u.user_id)
+				FROM reviewers r
+				JOIN users u ON r.user_id = u.id
+				WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+			)
+		FROM pull_requests p 
+		JOIN users a ON p.author_id = a.id
+		JOIN teams t ON a.team_id = t.id
+		WHERE p.pull_request_id = $1;
+		`,
+		pullRequestID,
+	)
+
+	pullRequest := models.PullRequest{
+		ID: pullRequestID,
+	}
+	var reviewersCount int
+	var reviewers, verdicts string
+
+	err := getPR.Scan(
+		&pullRequest.Name,
+		&pullRequest.AuthorID,
+		&pullRequest.Status,
+		&pullRequest.CreatedAt,
+		&pullRequest.MergedAt,
+		&reviewersCount,
+		&reviewers,
+		&verdicts,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PullRequest{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = parseReviewers(&pullRequest, reviewers, verdicts)
+	if err != nil {
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pullRequest.ReviewersMissing = repositories.ReviewersMissing(&pullRequest, reviewersCount)
+
+	return pullRequest, nil
+}
+
+// Получает PR в который данные пользователь назначен ревьювером
+func (s *Storage) GetReview(
+	ctx context.Context,
+	userID string,
+) ([]models.PullRequest, error) {
+	const op = "repositories.sqlite.GetReview"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Получаем пользователя и все пул реквесты в которых он ревьювер,
+	// у пользователя без них будет одна строка с пустыми полями PR
+	getPullRequests, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT p.pull_request_id, p.pull_request_name, a.user_id, p.status
+		FROM users u
+		LEFT JOIN reviewers r ON r.user_id = u.id AND r.unassigned_at IS NULL
+		LEFT JOIN pull_requests p ON r.pull_request_id = p.id
+		LEFT JOIN users a ON p.author_id = a.id
+		WHERE u.user_id = $1
+		ORDER BY r.id;
+		`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPullRequests.Close()
+
+	// Читаем строчки
+	found := false
+	var pullRequests []models.PullRequest
+	for getPullRequests.Next() {
+		var id, name, authorID, status *string
+		err := getPullRequests.Scan(&id, &name, &authorID, &status)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		found = true
+
+		// Пользователь не назначен ни на один пул реквест
+		if id == nil {
+			continue
+		}
+
+		pullRequests = append(pullRequests, models.PullRequest{
+			ID:       *id,
+			Name:     *name,
+			AuthorID: *authorID,
+			Status:   *status,
+		})
+	}
+	if err := getPullRequests.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if !found {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return pullRequests, nil
+}
+
+// Помечает PR как MERGED
+func (s *Storage) MergePullRequest(
+	ctx context.Context,
+	pullRequestID string,
+	mergedAt time.Time,
+) error {
+	const op = "repositories.sqlite.MergePullRequest"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем статус пул реквеста
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE pull_requests 
+		SET status = $1, merged_at = $2 
+		WHERE pull_request_id = $3;
+		`,
+		models.PULLREQUEST_MERGED, timestamp(mergedAt), pullRequestID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Меняет статус PR
+func (s *Storage) SetStatus(
+	ctx context.Context,
+	pullRequestID string,
+	status models.PRStatus,
+) error {
+	const op = "repositories.sqlite.SetStatus"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем статус пул реквеста
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE pull_requests 
+		SET status = $1
+		WHERE pull_request_id = $2;
+		`,
+		status, pullRequestID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пул реквест не найден
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает страницу PR, подходящих под фильтр, одним запросом
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter models.PullRequestFilter,
+) ([]models.PullRequest, error) {
+	const op = "repositories.sqlite.ListPullRequests"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	conditions := []string{"TRUE"}
+	args := []any{}
+
+	// Поле сортировки подставляется в запрос, поэтому берём его только из списка.
+	// У несмердженных PR merged_at хранит нулевое время, поэтому для сортировки
+	// оно заменяется на NULL, и такие PR идут в конце списка
+	var sortColumn string
+	switch filter.SortBy {
+	case models.PULLREQUEST_SORT_CREATED_AT:
+		sortColumn = "p.created_at"
+	case models.PULLREQUEST_SORT_MERGED_AT:
+		args = append(args, models.PULLREQUEST_MERGED)
+		sortColumn = fmt.Sprintf("(CASE WHEN p.status = $%d THEN p.merged_at END)", len(args))
+	default:
+		return nil, fmt.Errorf("%s: unknown sort field %q", op, filter.SortBy)
+	}
+
+	// Собираем условия фильтра
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.AuthorID != "" {
+		addCondition("a.user_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		addCondition(
+			`
+			EXISTS (
+				SELECT 1
+				FROM reviewers fr
+				JOIN users fu ON fr.user_id = fu.id
+				WHERE fr.pull_request_id = p.id AND fr.unassigned_at IS NULL AND fu.user_id = $%d
+			)
+			`,
+			filter.ReviewerID,
+		)
+	}
+	if filter.TeamName != "" {
+		addCondition("t.team_name = $%d", filter.TeamName)
+	}
+	if filter.Status != "" {
+		addCondition("p.status = $%d", filter.Status)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		addCondition("p.created_at >= $%d", timestamp(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCondition("p.created_at < $%d", timestamp(filter.CreatedTo))
+	}
+	if !filter.MergedFrom.IsZero() {
+		addCondition("p.merged_at >= $%d", timestamp(filter.MergedFrom))
+	}
+	if !filter.MergedTo.IsZero() {
+		// У несмердженных PR merged_at хранит нулевое время
+		addCondition("p.status = $%d", models.PULLREQUEST_MERGED)
+		addCondition("p.merged_at < $%d", timestamp(filter.MergedTo))
+	}
+
+	// Страница начинается строго после курсора
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	switch {
+	case filter.After == nil:
+	// После несмердженного PR идут только несмердженные
+	case filter.After.SortValue.IsZero():
+		args = append(args, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf(
+			"%s IS NULL AND p.pull_request_id %s $%d",
+			sortColumn, comparison, len(args),
+		))
+	default:
+		args = append(args, timestamp(filter.After.SortValue), filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf(
+			"((%s, p.pull_request_id) %s ($%d, $%d) OR %s IS NULL)",
+			sortColumn, comparison, len(args)-1, len(args), sortColumn,
+		))
+	}
+
+	args = append(args, filter.Limit)
+
+	// Ревьюверы и их вердикты собираются в JSON массивы в том же запросе
+	getPullRequests, err := conn.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			SELECT 
+				p.pull_request_id, p.pull_request_name, a.user_id, p.status, 
+				p.created_at, p.merged_at, t.reviewers_count,
+				COALESCE(rv.reviewers, '[]'), COALESCE(rv.verdicts, '[]')
+			FROM pull_requests p
+			JOIN users a ON p.author_id = a.id
+			JOIN teams t ON a.team_id = t.id
+			LEFT JOIN (
+				SELECT 
+					r.pull_request_id,
+					json_group_array(u.user_id ORDER BY u.user_id) AS reviewers,
+					json_group_array(r.verdict ORDER BY u.user_id) AS verdicts
+				FROM reviewers r
+				JOIN users u ON r.user_id = u.id
+				WHERE r.unassigned_at IS NULL
+				GROUP BY r.pull_request_id
+			) rv ON rv.pull_request_id = p.id
+			WHERE %s
+			ORDER BY %s %s NULLS LAST, p.pull_request_id %s
+			LIMIT $%d;
+			`,
+			strings.Join(conditions, " AND "),
+			sortColumn, direction, direction,
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPullRequests.Close()
+
+	// Читаем строчки
+	pullRequests := make([]models.PullRequest, 0, filter.Limit)
+	for getPullRequests.Next() {
+		var pullRequest models.PullRequest
+		var reviewersCount int
+		var reviewers, verdicts string
+		err := getPullRequests.Scan(
+			&pullRequest.ID,
+			&pullRequest.Name,
+			&pullRequest.AuthorID,
+			&pullRequest.Status,
+			&pullRequest.CreatedAt,
+			&pullRequest.MergedAt,
+			&reviewersCount,
+			&reviewers,
+			&verdicts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = parseReviewers(&pullRequest, reviewers, verdicts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		pullRequest.ReviewersMissing = repositories.ReviewersMissing(&pullRequest, reviewersCount)
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+	if err := getPullRequests.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// Разбирает JSON массивы ревьюверов пул реквеста и их вердиктов
+func parseReviewers(pullRequest *models.PullRequest, reviewers string, verdicts string) error {
+	var reviewVerdicts []*string
+	err := json.Unmarshal([]byte(reviewers), &pullRequest.AssignedReviewers)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal([]byte(verdicts), &reviewVerdicts)
+	if err != nil {
+		return err
+	}
+
+	// Массивы ревьюверов и вердиктов упорядочены одинаково,
+	// ревьювер без вердикта его ещё не оставил
+	pullRequest.Reviews = []models.Review{}
+	for i, verdict := range reviewVerdicts {
+		if verdict != nil {
+			pullRequest.Reviews = append(pullRequest.Reviews, models.Review{
+				ReviewerID: pullRequest.AssignedReviewers[i],
+				Verdict:    *verdict,
+			})
+		}
+	}
+
+	return nil
+}
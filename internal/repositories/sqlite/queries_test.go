@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/repotest"
+	"github.com/stretchr/testify/require"
+	"modernc.org/sqlite"
+)
+
+// Количество запросов через драйвер sqlite-counting
+var queries atomic.Int64
+
+func init() {
+	sql.Register("sqlite-counting", countingDriver{&sqlite.Driver{}})
+}
+
+// Драйвер, считающий выполненные запросы
+type countingDriver struct {
+	driver.Driver
+}
+
+func (d countingDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return countingConn{conn}, nil
+}
+
+type countingConn struct {
+	driver.Conn
+}
+
+func (c countingConn) Prepare(query string) (driver.Stmt, error) {
+	queries.Add(1)
+	return c.Conn.Prepare(query)
+}
+
+func (c countingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+func (c countingConn) ExecContext(
+	ctx context.Context,
+	query string,
+	args []driver.NamedValue,
+) (driver.Result, error) {
+	queries.Add(1)
+	return c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+func (c countingConn) QueryContext(
+	ctx context.Context,
+	query string,
+	args []driver.NamedValue,
+) (driver.Rows, error) {
+	queries.Add(1)
+	return c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+}
+
+func TestStorage_QueryCounts(t *testing.T) {
+	db, err := sql.Open("sqlite-counting", dsn(filepath.Join(t.TempDir(), "test.db")))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrate(t, db)
+
+	s := &Storage{
+		db:       db,
+		getter:   trmsql.DefaultCtxGetter,
+		strategy: repositories.RandomStrategy{},
+	}
+	txManager := manager.Must(trmsql.NewDefaultFactory(db))
+
+	// В SQLite нет изменяющих CTE, поэтому эти методы
+	// изменяют данные двумя запросами
+	repotest.CheckQueryCounts(t, txManager, s, queries.Load, map[string]int{
+		"ReassignReviewer": 2,
+		"RollupMergeTimes": 2,
+	})
+}
@@ -0,0 +1,409 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Условие, исключающее кандидатов, у которых открытых ревью
+// уже столько, сколько им разрешено.
+// Кандидаты выбираются из таблицы users с псевдонимом u
+func underCapacityCondition() string {
+	return fmt.Sprintf(
+		`
+		(
+			u.max_open_reviews IS NULL OR
+			(
+				SELECT COUNT(*)
+				FROM reviewers cr
+				JOIN pull_requests cp ON cr.pull_request_id = cp.id
+				WHERE cr.user_id = u.id AND cr.unassigned_at IS NULL AND cp.status = '%s'
+			) < u.max_open_reviews
+		)
+		`,
+		models.PULLREQUEST_OPEN,
+	)
+}
+
+// Назначает наблюдателей на пул реквест, пока их количество
+// не достигнет заданного командой автора. Возвращает ID назначенных
+func (s *Storage) AssignReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	authorID string,
+	reason models.AssignmentReason,
+) ([]string, error) {
+	const op = "repositories.sqlite.AssignReviewers"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Время назначения хранится с точностью до секунды, как время
+	// создания и мерджа PR, иначе время до мерджа бывает отрицательным
+	now := time.Now()
+	assignedAt := now.Truncate(time.Second)
+
+	// Назначаем доступных членов команды автора, пока ревьюверов не станет
+	// столько, сколько задано командой. Отсутствующие сейчас и уже
+	// загруженные до предела пользователи не назначаются
+	getReviewers, err := conn.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			WITH pr AS (
+				SELECT 
+					p.id, a.id AS author_id, a.team_id,
+					MAX(
+						t.reviewers_count - (
+							SELECT COUNT(*)
+							FROM reviewers r
+							WHERE r.pull_request_id = p.id AND r.unassigned_at IS NULL
+						),
+						0
+					) AS missing
+				FROM pull_requests p
+				JOIN users a ON a.user_id = $2
+				JOIN teams t ON a.team_id = t.id
+				WHERE p.pull_request_id = $1
+			)
+			INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+			SELECT pr.id, u.id, $3, $5
+			FROM users u, pr
+			WHERE 
+				u.id <> pr.author_id AND 
+				u.team_id = pr.team_id AND 
+				u.is_active = TRUE AND
+				u.id NOT IN (
+					SELECT user_id 
+					FROM reviewers
+					WHERE pull_request_id = pr.id AND unassigned_at IS NULL
+				) AND
+				%s AND
+				%s
+			ORDER BY %s
+			LIMIT COALESCE((SELECT missing FROM pr), 0)
+			RETURNING (SELECT user_id FROM users WHERE id = reviewers.user_id);
+			`,
+			notAbsentCondition(4),
+			underCapacityCondition(),
+			s.strategy.OrderBy(),
+		),
+		pullRequestID, authorID, reason, timestamp(now), timestamp(assignedAt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, reviewersViolation(err))
+	}
+	defer getReviewers.Close()
+
+	// Если подходящих юзеров в команде нет, то никого не назначаем
+	reviewerIDs := make([]string, 0)
+	for getReviewers.Next() {
+		var reviewerID string
+		err := getReviewers.Scan(&reviewerID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+	if err := getReviewers.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, reviewersViolation(err))
+	}
+
+	return reviewerIDs, nil
+}
+
+// Назначает на пул реквест заданных ревьюверов, не проверяя их
+// команду, активность и нагрузку
+func (s *Storage) AddReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerIDs []string,
+	reason models.AssignmentReason,
+) error {
+	const op = "repositories.sqlite.AddReviewers"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	assignedAt := time.Now().Truncate(time.Second)
+
+	// Ревьюверы вставляются, только если существуют все
+	result, err := conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+		SELECT p.id, u.id, $3, $4
+		FROM pull_requests p
+		JOIN users u ON u.user_id IN (SELECT value FROM json_each($2))
+		WHERE 
+			p.pull_request_id = $1 AND
+			(SELECT COUNT(*) FROM users WHERE user_id IN (SELECT value FROM json_each($2))) = $5;
+		`,
+		pullRequestID, jsonArray(reviewerIDs), reason, timestamp(assignedAt), len(reviewerIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, reviewersViolation(err))
+	}
+
+	// Пул реквест или кто-то из ревьюверов не найден
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if inserted != int64(len(reviewerIDs)) {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Переназначает наблюдателя на пул реквест. Старый ревьювер
+// снимается, но остаётся в истории назначений. Пишущие транзакции
+// SQLite выполняются по очереди, поэтому skipLocked не нужен
+func (s *Storage) ReassignReviewer(
+	ctx context.Context,
+	pullRequestID string,
+	oldReviewerID string,
+	reason models.AssignmentReason,
+	skipLocked bool,
+) (string, error) {
+	const op = "repositories.sqlite.ReassignReviewer"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	now := time.Now()
+	reassignedAt := now.Truncate(time.Second)
+
+	// Назначаем нового ревьювера из команды старого среди присутствующих
+	// сейчас и не загруженных до предела пользователей
+	assign := conn.QueryRowContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			WITH
+				pr AS (
+					SELECT id, author_id
+					FROM pull_requests
+					WHERE pull_request_id = $1
+				),
+				old AS (
+					SELECT u.id, u.team_id
+					FROM users u
+					JOIN reviewers r ON r.user_id = u.id
+					JOIN pr ON r.pull_request_id = pr.id
+					WHERE u.user_id = $2 AND r.unassigned_at IS NULL
+				)
+			INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+			SELECT pr.id, u.id, $3, $5
+			FROM users u, pr, old
+			WHERE 
+				u.is_active = TRUE AND
+				u.team_id = old.team_id AND
+				u.id <> old.id AND
+				u.id <> pr.author_id AND
+				u.id NOT IN (
+					SELECT user_id 
+					FROM reviewers
+					WHERE pull_request_id = pr.id AND unassigned_at IS NULL
+				) AND
+				%s AND
+				%s
+			ORDER BY %s
+			LIMIT 1
+			RETURNING pull_request_id, (SELECT user_id FROM users WHERE id = reviewers.user_id);
+			`,
+			notAbsentCondition(4),
+			underCapacityCondition(),
+			s.strategy.OrderBy(),
+		),
+		pullRequestID, oldReviewerID, reason, timestamp(now), timestamp(reassignedAt),
+	)
+
+	var prID int64
+	var newReviewerID string
+	err := assign.Scan(&prID, &newReviewerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%s: %w", op, s.reassignFailure(ctx, pullRequestID, oldReviewerID))
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, reviewersViolation(err))
+	}
+
+	// В SQLite нет изменяющих CTE, поэтому старый ревьювер снимается
+	// отдельным запросом. Его вердикт остаётся в истории
+	_, err = conn.ExecContext(
+		ctx,
+		`
+		UPDATE reviewers 
+		SET unassigned_at = $1
+		WHERE 
+			pull_request_id = $2 AND 
+			user_id = (SELECT id FROM users WHERE user_id = $3) AND 
+			unassigned_at IS NULL
+		`,
+		timestamp(reassignedAt), prID, oldReviewerID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return newReviewerID, nil
+}
+
+// Объясняет, почему новый ревьювер не назначился: пул реквест не найден
+// или старый ревьювер на него не назначен, либо нет подходящего кандидата
+func (s *Storage) reassignFailure(
+	ctx context.Context,
+	pullRequestID string,
+	oldReviewerID string,
+) error {
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	res := conn.QueryRowContext(
+		ctx,
+		`
+		SELECT EXISTS (
+			SELECT 1
+			FROM reviewers r
+			JOIN pull_requests p ON r.pull_request_id = p.id
+			JOIN users u ON r.user_id = u.id
+			WHERE p.pull_request_id = $1 AND u.user_id = $2 AND r.unassigned_at IS NULL
+		);
+		`,
+		pullRequestID, oldReviewerID,
+	)
+
+	var found bool
+	err := res.Scan(&found)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return repositories.ErrNotFound
+	}
+
+	return repositories.ErrNoCandidates
+}
+
+// Сохраняет вердикт ревьювера пул реквеста
+func (s *Storage) SetVerdict(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerID string,
+	verdict models.ReviewVerdict,
+) error {
+	const op = "repositories.sqlite.SetVerdict"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем вердикт
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE reviewers
+		SET verdict = $1
+		WHERE 
+			pull_request_id = (SELECT id FROM pull_requests WHERE pull_request_id = $2) AND
+			user_id = (SELECT id FROM users WHERE user_id = $3) AND
+			unassigned_at IS NULL;
+		`,
+		verdict, pullRequestID, reviewerID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Ревьювер не назначен на этот пул реквест
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает историю назначений ревьюверов пул реквеста в порядке назначения
+func (s *Storage) GetReviewerHistory(
+	ctx context.Context,
+	pullRequestID string,
+) ([]models.ReviewerAssignment, error) {
+	const op = "repositories.sqlite.GetReviewerHistory"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Получаем пул реквест и всех его ревьюверов, в том числе снятых,
+	// у пул реквеста без них будет одна строка с пустыми полями ревьювера
+	getHistory, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT u.user_id, r.reason, r.verdict, r.assigned_at, r.unassigned_at
+		FROM pull_requests p
+		LEFT JOIN reviewers r ON r.pull_request_id = p.id
+		LEFT JOIN users u ON r.user_id = u.id
+		WHERE p.pull_request_id = $1
+		ORDER BY r.assigned_at, r.id;
+		`,
+		pullRequestID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getHistory.Close()
+
+	found := false
+	history := make([]models.ReviewerAssignment, 0)
+	for getHistory.Next() {
+		var reviewerID, reason, verdict *string
+		var assignedAt, unassignedAt *time.Time
+		err := getHistory.Scan(
+			&reviewerID,
+			&reason,
+			&verdict,
+			&assignedAt,
+			&unassignedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		found = true
+
+		// Ревьюверы на пул реквест не назначались
+		if reviewerID == nil {
+			continue
+		}
+
+		assignment := models.ReviewerAssignment{
+			ReviewerID: *reviewerID,
+			Reason:     *reason,
+			AssignedAt: *assignedAt,
+		}
+		if verdict != nil {
+			assignment.Verdict = *verdict
+		}
+		if unassignedAt != nil {
+			assignment.UnassignedAt = *unassignedAt
+		}
+
+		history = append(history, assignment)
+	}
+	if err := getHistory.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пул реквест не найден
+	if !found {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return history, nil
+}
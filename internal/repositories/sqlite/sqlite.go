@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Код ошибки, если в БД вносятся повторяющиеся значения
+// для которых прописано UNIQUE
+const UNIQUE_VIOLATION_CODE = sqlite3.SQLITE_CONSTRAINT_UNIQUE
+
+// Код ошибки, если запись отклонена триггером через RAISE
+const TRIGGER_VIOLATION_CODE = sqlite3.SQLITE_CONSTRAINT_TRIGGER
+
+type Storage struct {
+	db       *sql.DB
+	getter   *trmsql.CtxGetter
+	strategy repositories.SelectionStrategy
+}
+
+func New(
+	path string,
+	maxConns int,
+	getter *trmsql.CtxGetter,
+	strategy repositories.SelectionStrategy,
+) (*Storage, error) {
+	const op = "repositories.sqlite.New"
+
+	db, err := sql.Open("sqlite", dsn(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	db.SetMaxOpenConns(maxConns)
+
+	// Проверяем, что файл открывается
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Storage{
+		db:       db,
+		getter:   getter,
+		strategy: strategy,
+	}, nil
+}
+
+// Строка подключения к файлу БД.
+// Транзакции сразу берут блокировку на запись, поэтому пишущие
+// транзакции ждут друг друга busy_timeout, а не падают с SQLITE_BUSY
+// при попытке начать запись после чтения. WAL позволяет читать
+// параллельно с записью
+func dsn(path string) string {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "synchronous(NORMAL)")
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Set("_txlock", "immediate")
+
+	return "file:" + path + "?" + query.Encode()
+}
+
+func (s *Storage) Stop() {
+	s.db.Close()
+}
+
+func (s *Storage) GetDB() *sql.DB {
+	return s.db
+}
+
+// Нарушено ли ограничение UNIQUE
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == UNIQUE_VIOLATION_CODE
+}
+
+// Переводит нарушение ограничений таблицы reviewers в ошибку хранилища,
+// остальные ошибки возвращает как есть. SQLite не сообщает имя
+// уникального индекса, только его столбцы
+func reviewersViolation(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch {
+	case sqliteErr.Code() == UNIQUE_VIOLATION_CODE &&
+		strings.Contains(sqliteErr.Error(), "reviewers.pull_request_id, reviewers.user_id"):
+		return repositories.ErrReviewerAssigned
+	case sqliteErr.Code() == TRIGGER_VIOLATION_CODE &&
+		strings.Contains(sqliteErr.Error(), repositories.REVIEWERS_NOT_AUTHOR):
+		return repositories.ErrAuthorReviewer
+	}
+
+	return err
+}
@@ -0,0 +1,347 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Создаёт хранилище во временном файле и применяет к нему миграции
+func newStorage(t *testing.T) (*Storage, *manager.Manager) {
+	t.Helper()
+
+	s, err := New(
+		filepath.Join(t.TempDir(), "test.db"),
+		4,
+		trmsql.DefaultCtxGetter,
+		repositories.RandomStrategy{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	migrate(t, s.GetDB())
+
+	return s, manager.Must(trmsql.NewDefaultFactory(s.GetDB()))
+}
+
+// Применяет миграции по порядку их номеров
+func migrate(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	paths, err := filepath.Glob("../../../migrations/sqlite/*.up.sql")
+	require.NoError(t, err)
+
+	version := func(path string) int {
+		number, _, _ := strings.Cut(filepath.Base(path), "_")
+		v, err := strconv.Atoi(number)
+		require.NoError(t, err)
+		return v
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		return version(a) - version(b)
+	})
+
+	for _, path := range paths {
+		migration, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		_, err = db.Exec(string(migration))
+		require.NoError(t, err, path)
+	}
+}
+
+// Создаёт команду с автором u1 и ревьювером u2
+func addTeam(t *testing.T, s *Storage) {
+	t.Helper()
+	ctx := context.Background()
+
+	teamID, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+
+	for _, userID := range []string{"u1", "u2"} {
+		require.NoError(t, s.AddUser(ctx, models.User{
+			UserID:   userID,
+			Username: userID,
+			TeamID:   teamID,
+			IsActive: true,
+		}))
+	}
+}
+
+func TestAddTeam_Exists(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+
+	_, err := s.AddTeam(ctx, "backend", 1)
+	require.NoError(t, err)
+
+	_, err = s.AddTeam(ctx, "backend", 1)
+	require.ErrorIs(t, err, repositories.ErrTeamExists)
+}
+
+func TestTxManager_Rollback(t *testing.T) {
+	s, txManager := newStorage(t)
+	ctx := context.Background()
+
+	errFailed := errors.New("failed")
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		_, err := s.AddTeam(ctx, "backend", 1)
+		require.NoError(t, err)
+
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	_, err = s.GetTeam(ctx, "backend")
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+}
+
+func TestPullRequest_RoundTrip(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+		ID:        "pr-1",
+		Name:      "pr-1",
+		AuthorID:  "u1",
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: createdAt,
+	}))
+
+	err := s.CreatePullRequest(ctx, models.PullRequest{
+		ID:       "pr-1",
+		AuthorID: "u1",
+		Status:   models.PULLREQUEST_OPEN,
+	})
+	require.ErrorIs(t, err, repositories.ErrPRExists)
+
+	reviewers, err := s.AssignReviewers(ctx, "pr-1", "u1", models.ASSIGNMENT_INITIAL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, reviewers)
+
+	pullRequest, err := s.GetPullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pullRequest.AssignedReviewers)
+	assert.True(t, createdAt.Equal(pullRequest.CreatedAt))
+	assert.True(t, pullRequest.MergedAt.IsZero())
+	assert.Zero(t, pullRequest.ReviewersMissing)
+}
+
+func TestListPullRequests_Pagination(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"pr-1", "pr-2", "pr-3"} {
+		createdAt = createdAt.Add(time.Hour)
+		require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        id,
+			Name:      id,
+			AuthorID:  "u1",
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: createdAt,
+		}))
+	}
+	_, err := s.AssignReviewers(ctx, "pr-3", "u1", models.ASSIGNMENT_INITIAL)
+	require.NoError(t, err)
+	require.NoError(t, s.SetVerdict(ctx, "pr-3", "u2", models.REVIEW_APPROVED))
+
+	filter := models.PullRequestFilter{
+		SortBy:     models.PULLREQUEST_SORT_CREATED_AT,
+		Descending: true,
+		Limit:      2,
+	}
+
+	page, err := s.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "pr-3", page[0].ID)
+	assert.Equal(t, []string{"u2"}, page[0].AssignedReviewers)
+	require.Len(t, page[0].Reviews, 1)
+	assert.Equal(t, models.REVIEW_APPROVED, page[0].Reviews[0].Verdict)
+	assert.Equal(t, "pr-2", page[1].ID)
+	assert.Empty(t, page[1].AssignedReviewers)
+
+	filter.After = &models.PullRequestCursor{
+		SortValue: page[1].CreatedAt,
+		ID:        page[1].ID,
+	}
+
+	page, err = s.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "pr-1", page[0].ID)
+}
+
+func TestListPullRequests_UnmergedLast(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"pr-1", "pr-2", "pr-3", "pr-4"} {
+		require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        id,
+			Name:      id,
+			AuthorID:  "u1",
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: createdAt,
+		}))
+	}
+	require.NoError(t, s.MergePullRequest(ctx, "pr-2", createdAt.Add(2*time.Hour)))
+	require.NoError(t, s.MergePullRequest(ctx, "pr-3", createdAt.Add(time.Hour)))
+
+	// Несмердженные PR идут в конце при любом направлении
+	for descending, want := range map[bool][]string{
+		false: {"pr-3", "pr-2", "pr-1", "pr-4"},
+		true:  {"pr-2", "pr-3", "pr-4", "pr-1"},
+	} {
+		filter := models.PullRequestFilter{
+			SortBy:     models.PULLREQUEST_SORT_MERGED_AT,
+			Descending: descending,
+			Limit:      len(want),
+		}
+
+		page, err := s.ListPullRequests(ctx, filter)
+		require.NoError(t, err)
+		ids := make([]string, len(page))
+		for i := range page {
+			ids[i] = page[i].ID
+		}
+		require.Equal(t, want, ids)
+
+		// Страница после смердженного и после несмердженного PR
+		for i := 1; i < len(want); i++ {
+			filter.After = &models.PullRequestCursor{ID: page[i].ID}
+			if page[i].Status == models.PULLREQUEST_MERGED {
+				filter.After.SortValue = page[i].MergedAt
+			}
+
+			next, err := s.ListPullRequests(ctx, filter)
+			require.NoError(t, err)
+			ids := make([]string, len(next))
+			for j := range next {
+				ids[j] = next[j].ID
+			}
+			assert.Equal(t, want[i+1:], ids)
+		}
+	}
+}
+
+func TestGetMergeTimes_Rollup(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	// PR смерджены за 1, 2 и 3 часа в разные дни
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, id := range []string{"pr-1", "pr-2", "pr-3"} {
+		createdAt := day.AddDate(0, 0, i).Add(time.Hour)
+		require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+			ID:        id,
+			AuthorID:  "u1",
+			Status:    models.PULLREQUEST_OPEN,
+			CreatedAt: createdAt,
+		}))
+		require.NoError(t, s.MergePullRequest(ctx, id, createdAt.Add(time.Duration(i+1)*time.Hour)))
+	}
+
+	to := day.AddDate(0, 0, 3)
+	before, err := s.GetMergeTimes(ctx, "backend", time.Time{}, to)
+	require.NoError(t, err)
+	assert.Equal(t, 3, before.MergedPullRequests)
+	assert.Equal(t, 2*time.Hour, before.P50)
+
+	// Первые два дня берутся из свёртки, результат не меняется
+	require.NoError(t, s.RollupMergeTimes(ctx, day.AddDate(0, 0, 2)))
+	require.NoError(t, s.RollupMergeTimes(ctx, day.AddDate(0, 0, 2)))
+
+	after, err := s.GetMergeTimes(ctx, "backend", time.Time{}, to)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	// Окно, начинающееся посреди первого дня, не берёт его из свёртки
+	window, err := s.GetMergeTimes(ctx, "backend", day.Add(90*time.Minute), to)
+	require.NoError(t, err)
+	assert.Equal(t, 3, window.MergedPullRequests)
+
+	window, err = s.GetMergeTimes(ctx, "backend", day.Add(3*time.Hour), to)
+	require.NoError(t, err)
+	assert.Equal(t, 2, window.MergedPullRequests)
+	assert.Equal(t, 2*time.Hour+30*time.Minute, window.P50)
+}
+
+func TestReviewers_Constraints(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+		ID:        "pr-1",
+		AuthorID:  "u1",
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now(),
+	}))
+	_, err := s.AssignReviewers(ctx, "pr-1", "u1", models.ASSIGNMENT_INITIAL)
+	require.NoError(t, err)
+
+	assign := func(userID string) error {
+		_, err := s.GetDB().Exec(
+			`
+			INSERT INTO reviewers (pull_request_id, user_id, reason, assigned_at)
+			SELECT p.id, u.id, 'initial', '2025-01-01'
+			FROM pull_requests p, users u
+			WHERE p.pull_request_id = 'pr-1' AND u.user_id = $1
+			`,
+			userID,
+		)
+		return reviewersViolation(err)
+	}
+
+	require.ErrorIs(t, assign("u2"), repositories.ErrReviewerAssigned)
+	require.ErrorIs(t, assign("u1"), repositories.ErrAuthorReviewer)
+}
+
+func TestAddReviewers(t *testing.T) {
+	s, _ := newStorage(t)
+	ctx := context.Background()
+	addTeam(t, s)
+
+	require.NoError(t, s.CreatePullRequest(ctx, models.PullRequest{
+		ID:        "pr-1",
+		AuthorID:  "u1",
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now(),
+	}))
+
+	// Ревьюверы назначаются все или никто
+	err := s.AddReviewers(ctx, "pr-1", []string{"u2", "u404"}, models.ASSIGNMENT_IMPORT)
+	require.ErrorIs(t, err, repositories.ErrNotFound)
+	err = s.AddReviewers(ctx, "pr-1", []string{"u1"}, models.ASSIGNMENT_IMPORT)
+	require.ErrorIs(t, err, repositories.ErrAuthorReviewer)
+
+	require.NoError(t, s.AddReviewers(ctx, "pr-1", []string{"u2"}, models.ASSIGNMENT_IMPORT))
+
+	history, err := s.GetReviewerHistory(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "u2", history[0].ReviewerID)
+	assert.Equal(t, models.ASSIGNMENT_IMPORT, history[0].Reason)
+}
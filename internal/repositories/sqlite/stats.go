@@ -0,0 +1,155 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Запрос статистики пользователей, отобранных условием condition по
+// таблицам users u и teams t. Первый параметр запроса - время,
+// с которого учитываются назначения и PR (NULL - за всё время).
+// percentile_cont в SQLite нет, поэтому вместо медианы запрос возвращает
+// JSON массив времён до мерджа в секундах
+func userStatsQuery(condition string) string {
+	return fmt.Sprintf(
+		`
+		SELECT
+			u.user_id, u.username, t.team_name,
+			COALESCE(rv.assigned, 0) AS assigned,
+			COALESCE(rv.open, 0),
+			COALESCE(rv.merged, 0) AS merged,
+			COALESCE(rv.reassigned, 0),
+			COALESCE(rv.merge_seconds, '[]'),
+			(
+				SELECT COUNT(*)
+				FROM pull_requests ap
+				WHERE ap.author_id = u.id AND ($1 IS NULL OR ap.created_at >= $1)
+			)
+		FROM users u
+		JOIN teams t ON u.team_id = t.id
+		LEFT JOIN (
+			SELECT
+				r.user_id,
+				COUNT(*) AS assigned,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[1]s'
+				) AS open,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[2]s'
+				) AS merged,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NOT NULL
+				) AS reassigned,
+				json_group_array(%[3]s) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[2]s'
+				) AS merge_seconds
+			FROM reviewers r
+			JOIN pull_requests p ON r.pull_request_id = p.id
+			WHERE $1 IS NULL OR r.assigned_at >= $1
+			GROUP BY r.user_id
+		) rv ON rv.user_id = u.id
+		WHERE %[4]s
+		ORDER BY merged DESC, assigned DESC, u.user_id;
+		`,
+		models.PULLREQUEST_OPEN,
+		models.PULLREQUEST_MERGED,
+		secondsBetween("p.merged_at", "r.assigned_at"),
+		condition,
+	)
+}
+
+// Получает статистику пользователя
+func (s *Storage) GetUserStats(
+	ctx context.Context,
+	userID string,
+	from time.Time,
+) (models.UserStats, error) {
+	const op = "repositories.sqlite.GetUserStats"
+
+	stats, err := s.queryUserStats(ctx, "u.user_id = $2", userID, from)
+	if err != nil {
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(stats) == 0 {
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return stats[0], nil
+}
+
+// Получает статистику членов команды, упорядоченную для рейтинга:
+// сначала по ревью смердженных PR, затем по всем назначениям
+func (s *Storage) GetTeamUserStats(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+) ([]models.UserStats, error) {
+	const op = "repositories.sqlite.GetTeamUserStats"
+
+	stats, err := s.queryUserStats(ctx, "t.team_name = $2", teamName, from)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) queryUserStats(
+	ctx context.Context,
+	condition string,
+	arg any,
+	from time.Time,
+) ([]models.UserStats, error) {
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Нулевое время учитывает всё
+	getStats, err := conn.QueryContext(ctx, userStatsQuery(condition), nullableTimestamp(from), arg)
+	if err != nil {
+		return nil, err
+	}
+	defer getStats.Close()
+
+	stats := make([]models.UserStats, 0)
+	for getStats.Next() {
+		var userStats models.UserStats
+		var mergeSeconds string
+		err := getStats.Scan(
+			&userStats.UserID,
+			&userStats.Username,
+			&userStats.TeamName,
+			&userStats.ReviewsAssigned,
+			&userStats.OpenReviews,
+			&userStats.MergedReviews,
+			&userStats.ReassignedAway,
+			&mergeSeconds,
+			&userStats.PullRequestsAuthored,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var seconds []float64
+		err = json.Unmarshal([]byte(mergeSeconds), &seconds)
+		if err != nil {
+			return nil, err
+		}
+
+		// Медиана считается в секундах
+		if median, ok := repositories.Percentile(seconds, 0.5); ok {
+			userStats.MedianTimeToMerge = repositories.SecondsToDuration(median)
+		}
+
+		stats = append(stats, userStats)
+	}
+	if err := getStats.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
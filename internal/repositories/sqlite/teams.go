@@ -0,0 +1,289 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Вносит команду в БД и возвращает её ID
+func (s *Storage) AddTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) (int64, error) {
+	const op = "repositories.sqlite.AddTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Вставить команду в базу
+	insertID := conn.QueryRowContext(
+		ctx,
+		"INSERT INTO teams (team_name, reviewers_count) VALUES ($1, $2) RETURNING id;",
+		teamName, reviewersCount,
+	)
+
+	var id int64
+	err := insertID.Scan(&id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, fmt.Errorf("%s: %w", op, repositories.ErrTeamExists)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// Получает команду по ее названию вместе с её членами
+func (s *Storage) GetTeam(
+	ctx context.Context,
+	teamName string,
+) (models.Team, error) {
+	const op = "repositories.sqlite.GetTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Получаем команду и её членов, у команды без членов
+	// будет одна строка с пустыми полями пользователя
+	getTeam, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT t.reviewers_count, u.user_id, u.username, u.is_active, u.max_open_reviews
+		FROM teams t
+		LEFT JOIN users u ON u.team_id = t.id
+		WHERE t.team_name = $1
+		ORDER BY u.id;
+		`,
+		teamName,
+	)
+	if err != nil {
+		return models.Team{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getTeam.Close()
+
+	team := models.Team{
+		TeamName: teamName,
+	}
+	found := false
+	members := make([]models.User, 0, 8)
+	for getTeam.Next() {
+		var userID, username *string
+		var isActive *bool
+		var member models.User
+
+		err = getTeam.Scan(
+			&team.ReviewersCount,
+			&userID,
+			&username,
+			&isActive,
+			&member.MaxOpenReviews,
+		)
+		if err != nil {
+			return models.Team{}, fmt.Errorf("%s: %w", op, err)
+		}
+		found = true
+
+		// У команды нет членов
+		if userID == nil {
+			continue
+		}
+
+		member.UserID = *userID
+		member.Username = *username
+		member.IsActive = *isActive
+		members = append(members, member)
+	}
+	if err := getTeam.Err(); err != nil {
+		return models.Team{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Команда не найдена
+	if !found {
+		return models.Team{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	team.Members = members
+	return team, nil
+}
+
+// Деактивирует пользователей команды
+func (s *Storage) DeactivateTeam(
+	ctx context.Context,
+	teamName string,
+) error {
+	const op = "repositories.sqlite.DeactivateTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Деактивируем всех пользователей данной команды
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE users
+		SET is_active = FALSE
+		WHERE team_id = (SELECT id FROM teams WHERE team_name = $1);
+		`,
+		teamName,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	// В SQLite нет изменяющих CTE, поэтому отсутствие команды
+	// отличается от команды без членов отдельным запросом
+	getTeamID := conn.QueryRowContext(
+		ctx,
+		"SELECT id FROM teams WHERE team_name = $1;",
+		teamName,
+	)
+
+	var teamID int64
+	err = getTeamID.Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Обновляет настройки команды
+func (s *Storage) UpdateTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) error {
+	const op = "repositories.sqlite.UpdateTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем количество ревьюверов
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE teams
+		SET reviewers_count = $1
+		WHERE team_name = $2;
+		`,
+		reviewersCount, teamName,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Команда не найдена
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Получает количество пул реквестов команды по статусам
+func (s *Storage) GetTeamsPullRequests(
+	ctx context.Context,
+	teamName string,
+) (map[models.PRStatus]int, error) {
+	const op = "repositories.sqlite.GetTeamsPullRequests"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Считаем пул реквесты команды по статусам
+	getPRStatuses, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT p.status, COUNT(*)
+		FROM pull_requests p
+		JOIN users u ON p.author_id = u.id
+		JOIN teams t ON u.team_id = t.id
+		WHERE t.team_name = $1
+		GROUP BY p.status
+		`,
+		teamName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPRStatuses.Close()
+
+	// Статусы без пул реквестов в выборку не попадут
+	statuses := make(map[models.PRStatus]int)
+	for getPRStatuses.Next() {
+		var status string
+		var count int
+		err := getPRStatuses.Scan(&status, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		statuses[status] = count
+	}
+	if err := getPRStatuses.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+// Получает количество открытых пул реквестов каждой команды
+func (s *Storage) GetOpenPullRequestsByTeam(
+	ctx context.Context,
+) (map[string]int, error) {
+	const op = "repositories.sqlite.GetOpenPullRequestsByTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	rows, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT t.team_name, COUNT(p.id)
+		FROM teams t
+		LEFT JOIN users u ON u.team_id = t.id
+		LEFT JOIN pull_requests p ON p.author_id = u.id AND p.status = $1
+		GROUP BY t.team_name
+		`,
+		models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	// Команды без открытых пул реквестов попадут в выборку с нулём
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		err := rows.Scan(&teamName, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		counts[teamName] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return counts, nil
+}
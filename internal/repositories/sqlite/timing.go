@@ -0,0 +1,295 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Границы дней, целиком попадающих в окно [from, to): дни day
+// с fromDay <= day < toDay. Нулевое from означает отсутствие границы
+func dayBounds(from time.Time, to time.Time) (string, string) {
+	fromDay := time.Time{}
+	if !from.IsZero() {
+		fromDay = from.UTC().Truncate(24 * time.Hour)
+		if fromDay.Before(from) {
+			fromDay = fromDay.AddDate(0, 0, 1)
+		}
+	}
+	toDay := to.UTC().Truncate(24 * time.Hour)
+
+	return fromDay.Format(DAY_FORMAT), toDay.Format(DAY_FORMAT)
+}
+
+// Получает перцентили времени до мерджа PR команды, смердженных в [from, to).
+// Свёрнутые дни, целиком попадающие в окно, берутся из merge_time_rollups.
+// Перцентили считаются по выбранным значениям на стороне приложения
+func (s *Storage) GetMergeTimes(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+) (models.MergeTimes, error) {
+	const op = "repositories.sqlite.GetMergeTimes"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	fromDay, toDay := dayBounds(from, to)
+
+	// rolled_until всегда начало дня, поэтому свёрнуты дни до его даты
+	getMergeTimes := conn.QueryRowContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			WITH
+				state AS (
+					SELECT date(rolled_until) AS rolled_day FROM merge_time_rollup_state
+				),
+				durations AS (
+					SELECT d.value AS seconds
+					FROM merge_time_rollups r
+					JOIN teams t ON r.team_id = t.id
+					CROSS JOIN state
+					CROSS JOIN json_each(r.merge_seconds) d
+					WHERE
+						t.team_name = $1 AND
+						r.day < state.rolled_day AND
+						r.day >= $2 AND r.day < $3
+
+					UNION ALL
+
+					SELECT %s
+					FROM pull_requests p
+					JOIN users u ON p.author_id = u.id
+					JOIN teams t ON u.team_id = t.id
+					CROSS JOIN state
+					WHERE
+						t.team_name = $1 AND
+						p.status = $6 AND
+						($4 IS NULL OR p.merged_at >= $4) AND p.merged_at < $5 AND
+						NOT (
+							date(p.merged_at) < state.rolled_day AND
+							date(p.merged_at) >= $2 AND date(p.merged_at) < $3
+						)
+				)
+			SELECT json_group_array(seconds)
+			FROM durations;
+			`,
+			secondsBetween("p.merged_at", "p.created_at"),
+		),
+		teamName, fromDay, toDay, nullableTimestamp(from), timestamp(to), models.PULLREQUEST_MERGED,
+	)
+
+	var durations string
+	err := getMergeTimes.Scan(&durations)
+	if err != nil {
+		return models.MergeTimes{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var seconds []float64
+	err = json.Unmarshal([]byte(durations), &seconds)
+	if err != nil {
+		return models.MergeTimes{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mergeTimes := models.MergeTimes{
+		MergedPullRequests: len(seconds),
+	}
+
+	// Без смердженных PR перцентилей нет
+	if p50, ok := repositories.Percentile(seconds, 0.5); ok {
+		p90, _ := repositories.Percentile(seconds, 0.9)
+		p99, _ := repositories.Percentile(seconds, 0.99)
+
+		mergeTimes.P50 = repositories.SecondsToDuration(p50)
+		mergeTimes.P90 = repositories.SecondsToDuration(p90)
+		mergeTimes.P99 = repositories.SecondsToDuration(p99)
+	}
+
+	return mergeTimes, nil
+}
+
+// Считает открытые PR команды, созданные в [from, to), по корзинам возраста
+// на момент now. Корзин на одну больше чем границ bounds
+func (s *Storage) GetOpenPullRequestAges(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	now time.Time,
+	bounds []time.Duration,
+) ([]int, error) {
+	const op = "repositories.sqlite.GetOpenPullRequestAges"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	thresholds := make([]float64, len(bounds))
+	for i, bound := range bounds {
+		thresholds[i] = bound.Seconds()
+	}
+
+	// Номер корзины - количество границ, не больших возраста
+	getAges, err := conn.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			SELECT
+				(SELECT COUNT(*) FROM json_each($5) b WHERE b.value <= a.age) AS bucket,
+				COUNT(*)
+			FROM (
+				SELECT %s AS age
+				FROM pull_requests p
+				JOIN users u ON p.author_id = u.id
+				JOIN teams t ON u.team_id = t.id
+				WHERE
+					t.team_name = $1 AND
+					p.status = $6 AND
+					($2 IS NULL OR p.created_at >= $2) AND
+					p.created_at < $3
+			) a
+			GROUP BY bucket;
+			`,
+			secondsBetween("$4", "p.created_at"),
+		),
+		teamName, nullableTimestamp(from), timestamp(to), timestamp(now),
+		jsonArray(thresholds), models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getAges.Close()
+
+	// Пустые корзины в выборку не попадут
+	counts := make([]int, len(bounds)+1)
+	for getAges.Next() {
+		var bucket, count int
+		err := getAges.Scan(&bucket, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		counts[bucket] = count
+	}
+	if err := getAges.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return counts, nil
+}
+
+// Получает самые старые открытые PR команды, созданные в [from, to)
+func (s *Storage) GetOldestOpenPullRequests(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	limit int,
+) ([]models.PullRequest, error) {
+	const op = "repositories.sqlite.GetOldestOpenPullRequests"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	getPullRequests, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT p.pull_request_id, p.pull_request_name, u.user_id, p.status, p.created_at
+		FROM pull_requests p
+		JOIN users u ON p.author_id = u.id
+		JOIN teams t ON u.team_id = t.id
+		WHERE
+			t.team_name = $1 AND
+			p.status = $5 AND
+			($2 IS NULL OR p.created_at >= $2) AND
+			p.created_at < $3
+		ORDER BY p.created_at, p.pull_request_id
+		LIMIT $4;
+		`,
+		teamName, nullableTimestamp(from), timestamp(to), limit, models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPullRequests.Close()
+
+	pullRequests := make([]models.PullRequest, 0)
+	for getPullRequests.Next() {
+		var pullRequest models.PullRequest
+		err := getPullRequests.Scan(
+			&pullRequest.ID,
+			&pullRequest.Name,
+			&pullRequest.AuthorID,
+			&pullRequest.Status,
+			&pullRequest.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+	if err := getPullRequests.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// Сворачивает время до мерджа PR, смердженных до until, по командам и дням.
+// until должен быть началом дня, уже свёрнутые дни пропускаются
+func (s *Storage) RollupMergeTimes(
+	ctx context.Context,
+	until time.Time,
+) error {
+	const op = "repositories.sqlite.RollupMergeTimes"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// В SQLite нет изменяющих CTE, поэтому свёртка и сдвиг состояния - два
+	// запроса. Если второй не выполнится, следующая свёртка пропустит
+	// уже свёрнутые дни по конфликту ключа
+	_, err := conn.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			`
+			INSERT INTO merge_time_rollups (team_id, day, merge_seconds)
+			SELECT
+				u.team_id,
+				date(p.merged_at),
+				json_group_array(%s)
+			FROM pull_requests p
+			JOIN users u ON p.author_id = u.id
+			WHERE
+				p.status = $2 AND
+				u.team_id IS NOT NULL AND
+				p.merged_at >= (SELECT rolled_until FROM merge_time_rollup_state) AND
+				p.merged_at < $1
+			GROUP BY u.team_id, date(p.merged_at)
+			ON CONFLICT (team_id, day) DO NOTHING;
+			`,
+			secondsBetween("p.merged_at", "p.created_at"),
+		),
+		timestamp(until), models.PULLREQUEST_MERGED,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = conn.ExecContext(
+		ctx,
+		`
+		UPDATE merge_time_rollup_state
+		SET rolled_until = $1
+		WHERE rolled_until < $1;
+		`,
+		timestamp(until),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
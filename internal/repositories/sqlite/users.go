@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет пользователя, либо обновляет его в БД.
+func (s *Storage) AddUser(
+	ctx context.Context,
+	user models.User,
+) error {
+	const op = "repositories.sqlite.AddUser"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Вставляем юзера либо обновляем его
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO users (user_id, username, team_id, is_active, max_open_reviews)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			username = $2,
+			team_id = $3,
+			is_active = $4,
+			max_open_reviews = $5
+		;
+		`,
+		user.UserID, user.Username, user.TeamID, user.IsActive, user.MaxOpenReviews,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Возвращает пользователя по его ID
+func (s *Storage) GetUser(
+	ctx context.Context,
+	userID string,
+) (models.User, error) {
+	const op = "repositories.sqlite.GetUser"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Получаем данные о пользователе из БД
+	res := conn.QueryRowContext(
+		ctx,
+		`
+		SELECT u.username, t.team_name, u.is_active, u.max_open_reviews
+		FROM users u
+		JOIN teams t ON u.team_id = t.id
+		WHERE u.user_id = $1;
+		`,
+		userID,
+	)
+
+	user := models.User{
+		UserID: userID,
+	}
+	err := res.Scan(&user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.User{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// Меняет is_active у пользователя
+func (s *Storage) SetActive(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+) error {
+	const op = "repositories.sqlite.SetActive"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем is_active у пользователя
+	res, err := conn.ExecContext(
+		ctx,
+		`UPDATE users SET is_active = $1 WHERE user_id = $2`,
+		isActive, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Меняет max_open_reviews у пользователя
+func (s *Storage) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) error {
+	const op = "repositories.sqlite.SetMaxOpenReviews"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	// Обновляем ограничение открытых ревью
+	res, err := conn.ExecContext(
+		ctx,
+		`UPDATE users SET max_open_reviews = $1 WHERE user_id = $2`,
+		maxOpenReviews, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
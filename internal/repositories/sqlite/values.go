@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Формат хранения времени. Время хранится в UTC с дробной частью
+// фиксированной длины, поэтому строки сравниваются так же, как моменты
+// времени. Драйвер читает его обратно в time.Time по типу столбца TIMESTAMP
+const TIME_FORMAT = "2006-01-02 15:04:05.000000000"
+
+// Формат дня свёртки времени до мерджа
+const DAY_FORMAT = "2006-01-02"
+
+// Время в формате хранения. Передавать time.Time напрямую нельзя:
+// драйвер записывает его в формате, строки которого не сравниваются
+func timestamp(t time.Time) string {
+	return t.UTC().Format(TIME_FORMAT)
+}
+
+// Нулевое время означает отсутствие границы
+func nullableTimestamp(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamp(t)
+}
+
+// Выражение разницы моментов времени to - from в секундах. unixepoch
+// учитывает только миллисекунды, поэтому дробная часть секунд берётся
+// из строки формата хранения
+func secondsBetween(to string, from string) string {
+	return fmt.Sprintf(
+		"(unixepoch(%[1]s) - unixepoch(%[2]s) + (substr(%[1]s, 21) - substr(%[2]s, 21)) / 1e9)",
+		to, from,
+	)
+}
+
+// Массив в формате хранения (JSON массив)
+func jsonArray[T any](values []T) string {
+	if values == nil {
+		values = []T{}
+	}
+
+	// Срез строк сериализуется без ошибок
+	data, _ := json.Marshal(values)
+	return string(data)
+}
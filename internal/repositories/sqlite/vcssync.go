@@ -0,0 +1,205 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Кладёт задачу синхронизации в очередь. Вызывается в транзакции
+// изменения, поэтому задача сохраняется только вместе с ним
+func (s *Storage) EnqueueVCSSyncJob(
+	ctx context.Context,
+	job models.VCSSyncJob,
+	nextAttemptAt time.Time,
+) error {
+	const op = "repositories.sqlite.EnqueueVCSSyncJob"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO vcs_sync_jobs
+			(provider, repository, number, request_logins, remove_logins, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6);
+		`,
+		job.Provider, job.Repository, job.Number,
+		jsonArray(job.RequestLogins), jsonArray(job.RemoveLogins), timestamp(nextAttemptAt),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Забирает готовые к выполнению задачи и блокирует их до lockedUntil,
+// чтобы их не забрал другой экземпляр сервиса. Запись в SQLite
+// однопоточна, поэтому SKIP LOCKED не нужен
+func (s *Storage) ClaimVCSSyncJobs(
+	ctx context.Context,
+	now time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.VCSSyncJob, error) {
+	const op = "repositories.sqlite.ClaimVCSSyncJobs"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	rows, err := conn.QueryContext(
+		ctx,
+		`
+		UPDATE vcs_sync_jobs
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id
+			FROM vcs_sync_jobs
+			WHERE
+				done_at IS NULL AND
+				failed_at IS NULL AND
+				next_attempt_at <= $1 AND
+				(locked_until IS NULL OR locked_until <= $1)
+			ORDER BY next_attempt_at, id
+			LIMIT $3
+		)
+		RETURNING
+			id, provider, repository, number,
+			request_logins, remove_logins, attempts;
+		`,
+		timestamp(now), timestamp(lockedUntil), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	jobs := make([]models.VCSSyncJob, 0, limit)
+	for rows.Next() {
+		var job models.VCSSyncJob
+		var requestLogins, removeLogins string
+		err := rows.Scan(
+			&job.ID,
+			&job.Provider,
+			&job.Repository,
+			&job.Number,
+			&requestLogins,
+			&removeLogins,
+			&job.Attempts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = json.Unmarshal([]byte(requestLogins), &job.RequestLogins)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		err = json.Unmarshal([]byte(removeLogins), &job.RemoveLogins)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobs, nil
+}
+
+// Помечает задачу выполненной
+func (s *Storage) CompleteVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	doneAt time.Time,
+) error {
+	const op = "repositories.sqlite.CompleteVCSSyncJob"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE vcs_sync_jobs
+		SET
+			attempts = attempts + 1,
+			done_at = $1,
+			locked_until = NULL,
+			last_error = NULL
+		WHERE id = $2;
+		`,
+		timestamp(doneAt), jobID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Откладывает задачу до следующей попытки
+func (s *Storage) RetryVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	nextAttemptAt time.Time,
+	lastError string,
+) error {
+	const op = "repositories.sqlite.RetryVCSSyncJob"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE vcs_sync_jobs
+		SET
+			attempts = attempts + 1,
+			next_attempt_at = $1,
+			locked_until = NULL,
+			last_error = $2
+		WHERE id = $3;
+		`,
+		timestamp(nextAttemptAt), lastError, jobID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Прекращает попытки выполнить задачу
+func (s *Storage) FailVCSSyncJob(
+	ctx context.Context,
+	jobID int64,
+	failedAt time.Time,
+	lastError string,
+) error {
+	const op = "repositories.sqlite.FailVCSSyncJob"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE vcs_sync_jobs
+		SET
+			attempts = attempts + 1,
+			failed_at = $1,
+			locked_until = NULL,
+			last_error = $2
+		WHERE id = $3;
+		`,
+		timestamp(failedAt), lastError, jobID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,391 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+)
+
+// Добавляет подписку на события и возвращает её ID
+func (s *Storage) AddWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) (int64, error) {
+	const op = "repositories.sqlite.AddWebhook"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	res := conn.QueryRowContext(
+		ctx,
+		`
+		INSERT INTO webhooks (url, secret, events, is_active, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id;
+		`,
+		webhook.URL, webhook.Secret, jsonArray(webhook.Events), webhook.IsActive, timestamp(webhook.CreatedAt),
+	)
+
+	var id int64
+	err := res.Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// Возвращает подписку по её ID
+func (s *Storage) GetWebhook(
+	ctx context.Context,
+	webhookID int64,
+) (models.Webhook, error) {
+	const op = "repositories.sqlite.GetWebhook"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	res := conn.QueryRowContext(
+		ctx,
+		`
+		SELECT id, url, secret, events, is_active, created_at
+		FROM webhooks
+		WHERE id = $1;
+		`,
+		webhookID,
+	)
+
+	webhook, err := scanWebhook(res)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Webhook{}, fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+		}
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return webhook, nil
+}
+
+// Возвращает все подписки
+func (s *Storage) ListWebhooks(
+	ctx context.Context,
+) ([]models.Webhook, error) {
+	const op = "repositories.sqlite.ListWebhooks"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	rows, err := conn.QueryContext(
+		ctx,
+		`
+		SELECT id, url, secret, events, is_active, created_at
+		FROM webhooks
+		ORDER BY id;
+		`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]models.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return webhooks, nil
+}
+
+// Читает подписку из строки выборки.
+// События хранятся JSON массивом
+func scanWebhook(row interface{ Scan(dest ...any) error }) (models.Webhook, error) {
+	var webhook models.Webhook
+	var events string
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.URL,
+		&webhook.Secret,
+		&events,
+		&webhook.IsActive,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return models.Webhook{}, err
+	}
+
+	err = json.Unmarshal([]byte(events), &webhook.Events)
+	if err != nil {
+		return models.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// Обновляет подписку
+func (s *Storage) UpdateWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) error {
+	const op = "repositories.sqlite.UpdateWebhook"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	res, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE webhooks
+		SET url = $1, secret = $2, events = $3, is_active = $4
+		WHERE id = $5;
+		`,
+		webhook.URL, webhook.Secret, jsonArray(webhook.Events), webhook.IsActive, webhook.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Подписка не найдена
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Удаляет подписку вместе с её недоставленными событиями
+func (s *Storage) DeleteWebhook(
+	ctx context.Context,
+	webhookID int64,
+) error {
+	const op = "repositories.sqlite.DeleteWebhook"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	res, err := conn.ExecContext(
+		ctx,
+		`DELETE FROM webhooks WHERE id = $1`,
+		webhookID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Подписка не найдена
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Кладёт событие в исходящую очередь для каждой подходящей подписки.
+// Вызывается в транзакции изменения, поэтому событие сохраняется
+// только вместе с ним
+func (s *Storage) PublishEvent(
+	ctx context.Context,
+	event models.Event,
+) error {
+	const op = "repositories.sqlite.PublishEvent"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = conn.ExecContext(
+		ctx,
+		`
+		INSERT INTO webhook_deliveries (webhook_id, event, payload, next_attempt_at)
+		SELECT id, $1, $2, $3
+		FROM webhooks
+		WHERE
+			is_active = TRUE AND
+			(json_array_length(events) = 0 OR $1 IN (SELECT value FROM json_each(events)));
+		`,
+		event.Type, string(payload), timestamp(event.OccurredAt),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Забирает готовые к отправке доставки и блокирует их до lockedUntil,
+// чтобы их не забрал другой экземпляр сервиса. Запись в SQLite
+// однопоточна, поэтому SKIP LOCKED не нужен
+func (s *Storage) ClaimDeliveries(
+	ctx context.Context,
+	now time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.WebhookDelivery, error) {
+	const op = "repositories.sqlite.ClaimDeliveries"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	rows, err := conn.QueryContext(
+		ctx,
+		`
+		UPDATE webhook_deliveries
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE
+				delivered_at IS NULL AND
+				failed_at IS NULL AND
+				next_attempt_at <= $1 AND
+				(locked_until IS NULL OR locked_until <= $1)
+			ORDER BY next_attempt_at, id
+			LIMIT $3
+		)
+		RETURNING
+			id, event, payload, attempts,
+			(SELECT w.url FROM webhooks w WHERE w.id = webhook_id),
+			(SELECT w.secret FROM webhooks w WHERE w.id = webhook_id);
+		`,
+		timestamp(now), timestamp(lockedUntil), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]models.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var delivery models.WebhookDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Attempts,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return deliveries, nil
+}
+
+// Помечает доставку выполненной
+func (s *Storage) MarkDelivered(
+	ctx context.Context,
+	deliveryID int64,
+	deliveredAt time.Time,
+) error {
+	const op = "repositories.sqlite.MarkDelivered"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE webhook_deliveries
+		SET
+			attempts = attempts + 1,
+			delivered_at = $1,
+			locked_until = NULL,
+			last_error = NULL
+		WHERE id = $2;
+		`,
+		timestamp(deliveredAt), deliveryID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Откладывает доставку до следующей попытки
+func (s *Storage) RetryDelivery(
+	ctx context.Context,
+	deliveryID int64,
+	nextAttemptAt time.Time,
+	lastError string,
+) error {
+	const op = "repositories.sqlite.RetryDelivery"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE webhook_deliveries
+		SET
+			attempts = attempts + 1,
+			next_attempt_at = $1,
+			locked_until = NULL,
+			last_error = $2
+		WHERE id = $3;
+		`,
+		timestamp(nextAttemptAt), lastError, deliveryID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Прекращает попытки доставки
+func (s *Storage) FailDelivery(
+	ctx context.Context,
+	deliveryID int64,
+	failedAt time.Time,
+	lastError string,
+) error {
+	const op = "repositories.sqlite.FailDelivery"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.db)
+
+	_, err := conn.ExecContext(
+		ctx,
+		`
+		UPDATE webhook_deliveries
+		SET
+			attempts = attempts + 1,
+			failed_at = $1,
+			locked_until = NULL,
+			last_error = $2
+		WHERE id = $3;
+		`,
+		timestamp(failedAt), lastError, deliveryID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Запрос статистики пользователей, отобранных условием condition по
+// таблицам users u и teams t. Первый параметр запроса - время,
+// с которого учитываются назначения и PR (NULL - за всё время)
+func userStatsQuery(condition string) string {
+	return fmt.Sprintf(
+		`
+		SELECT
+			u.user_id, u.username, t.team_name,
+			rv.assigned, rv.open, rv.merged, rv.reassigned, rv.median,
+			(
+				SELECT COUNT(*)
+				FROM pull_requests ap
+				WHERE ap.author_id = u.id AND ($1::timestamp IS NULL OR ap.created_at >= $1)
+			)
+		FROM users u
+		JOIN teams t ON u.team_id = t.id
+		LEFT JOIN LATERAL (
+			SELECT
+				COUNT(*) AS assigned,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[1]s'
+				) AS open,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[2]s'
+				) AS merged,
+				COUNT(*) FILTER (
+					WHERE r.unassigned_at IS NOT NULL
+				) AS reassigned,
+				percentile_cont(0.5) WITHIN GROUP (
+					ORDER BY EXTRACT(EPOCH FROM p.merged_at - r.assigned_at)::float8
+				) FILTER (
+					WHERE r.unassigned_at IS NULL AND p.status = '%[2]s'
+				) AS median
+			FROM reviewers r
+			JOIN pull_requests p ON r.pull_request_id = p.id
+			WHERE r.user_id = u.id AND ($1::timestamp IS NULL OR r.assigned_at >= $1)
+		) rv ON TRUE
+		WHERE %[3]s
+		ORDER BY rv.merged DESC, rv.assigned DESC, u.user_id;
+		`,
+		models.PULLREQUEST_OPEN,
+		models.PULLREQUEST_MERGED,
+		condition,
+	)
+}
+
+// Получает статистику пользователя
+func (s *Storage) GetUserStats(
+	ctx context.Context,
+	userID string,
+	from time.Time,
+) (models.UserStats, error) {
+	const op = "repositories.postgres.GetUserStats"
+
+	stats, err := s.queryUserStats(ctx, "u.user_id = $2", userID, from)
+	if err != nil {
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(stats) == 0 {
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return stats[0], nil
+}
+
+// Получает статистику членов команды, упорядоченную для рейтинга:
+// сначала по ревью смердженных PR, затем по всем назначениям
+func (s *Storage) GetTeamUserStats(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+) ([]models.UserStats, error) {
+	const op = "repositories.postgres.GetTeamUserStats"
+
+	stats, err := s.queryUserStats(ctx, "t.team_name = $2", teamName, from)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) queryUserStats(
+	ctx context.Context,
+	condition string,
+	arg any,
+	from time.Time,
+) ([]models.UserStats, error) {
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Нулевое время учитывает всё
+	getStats, err := conn.Query(ctx, userStatsQuery(condition), nullableTime(from), arg)
+	if err != nil {
+		return nil, err
+	}
+	defer getStats.Close()
+
+	stats := make([]models.UserStats, 0)
+	for getStats.Next() {
+		var userStats models.UserStats
+		var median *float64
+		err := getStats.Scan(
+			&userStats.UserID,
+			&userStats.Username,
+			&userStats.TeamName,
+			&userStats.ReviewsAssigned,
+			&userStats.OpenReviews,
+			&userStats.MergedReviews,
+			&userStats.ReassignedAway,
+			&median,
+			&userStats.PullRequestsAuthored,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Медиана хранится в секундах
+		if median != nil {
+			userStats.MedianTimeToMerge = SecondsToDuration(*median)
+		}
+
+		stats = append(stats, userStats)
+	}
+
+	return stats, nil
+}
+
+// Непрерывный перцентиль с линейной интерполяцией, как percentile_cont
+// в Postgres. Нужен хранилищам, которые не умеют считать его сами.
+// ok = false, если значений нет. Сортирует values
+func Percentile(values []float64, fraction float64) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	slices.Sort(values)
+
+	position := fraction * float64(len(values)-1)
+	lower := math.Floor(position)
+	upper := math.Ceil(position)
+
+	low, high := values[int(lower)], values[int(upper)]
+	return low + (high-low)*(position-lower), true
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	_, ok := Percentile(nil, 0.5)
+	assert.False(t, ok)
+
+	median, ok := Percentile([]float64{4, 1, 3, 2}, 0.5)
+	require.True(t, ok)
+	assert.InDelta(t, 2.5, median, 1e-9)
+
+	p90, ok := Percentile([]float64{10, 20, 30, 40, 50}, 0.9)
+	require.True(t, ok)
+	assert.InDelta(t, 46, p90, 1e-9)
+
+	single, ok := Percentile([]float64{7}, 0.99)
+	require.True(t, ok)
+	assert.InDelta(t, 7, single, 1e-9)
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Названия стратегий выбора ревьюверов
+const (
+	STRATEGY_RANDOM       = "random"
+	STRATEGY_LEAST_LOADED = "least_loaded"
+)
+
+// Стратегия выбора ревьюверов среди подходящих кандидатов.
+// Кандидаты выбираются из таблицы users с псевдонимом u
+type SelectionStrategy interface {
+	// Возвращает выражение ORDER BY, по которому упорядочиваются кандидаты
+	OrderBy() string
+}
+
+// Возвращает стратегию по её названию
+func NewSelectionStrategy(name string) (SelectionStrategy, error) {
+	const op = "repositories.NewSelectionStrategy"
+
+	switch name {
+	case STRATEGY_RANDOM:
+		return RandomStrategy{}, nil
+	case STRATEGY_LEAST_LOADED:
+		return LeastLoadedStrategy{}, nil
+	}
+
+	return nil, fmt.Errorf("%s: %w: %s", op, ErrUnknownStrategy, name)
+}
+
+// Выбирает кандидатов случайно
+type RandomStrategy struct{}
+
+func (RandomStrategy) OrderBy() string {
+	return "RANDOM()"
+}
+
+// Выбирает кандидатов с наименьшим количеством открытых ревью,
+// при равенстве выбирает случайно
+type LeastLoadedStrategy struct{}
+
+func (LeastLoadedStrategy) OrderBy() string {
+	return fmt.Sprintf(
+		`
+		(
+			SELECT COUNT(*)
+			FROM reviewers lr
+			JOIN pull_requests lp ON lr.pull_request_id = lp.id
+			WHERE lr.user_id = u.id AND lr.unassigned_at IS NULL AND lp.status = '%s'
+		),
+		RANDOM()
+		`,
+		models.PULLREQUEST_OPEN,
+	)
+}
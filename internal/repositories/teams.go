@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
@@ -14,10 +13,11 @@ import (
 // для которых прописано UNIQUE
 const UNIQUE_VIOLATION_CODE = "23505"
 
-// Вносит имя команды в БД и возвращает её ID
+// Вносит команду в БД и возвращает её ID
 func (s *Storage) AddTeam(
 	ctx context.Context,
 	teamName string,
+	reviewersCount int,
 ) (int64, error) {
 	const op = "repositories.postgres.AddTeam"
 
@@ -26,8 +26,8 @@ func (s *Storage) AddTeam(
 	// Вставить команду в базу
 	insertID := conn.QueryRow(
 		ctx,
-		"INSERT INTO teams (team_name) VALUES ($1) RETURNING id;",
-		teamName,
+		"INSERT INTO teams (team_name, reviewers_count) VALUES ($1, $2) RETURNING id;",
+		teamName, reviewersCount,
 	)
 
 	var id int64
@@ -43,7 +43,7 @@ func (s *Storage) AddTeam(
 	return id, nil
 }
 
-// Получает команду по ее названию
+// Получает команду по ее названию вместе с её членами
 func (s *Storage) GetTeam(
 	ctx context.Context,
 	teamName string,
@@ -52,52 +52,64 @@ func (s *Storage) GetTeam(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID команды по её названию
-	getTeamID := conn.QueryRow(
-		ctx,
-		"SELECT id FROM teams WHERE team_name = $1;",
-		teamName,
-	)
-
-	var teamID int64
-	err := getTeamID.Scan(&teamID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return models.Team{}, fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return models.Team{}, fmt.Errorf("%s: %w", op, err)
-	}
-
-	// Получаем членов команды
-	getTeamMemdbers, err := conn.Query(
+	// Получаем команду и её членов, у команды без членов
+	// будет одна строка с пустыми полями пользователя
+	getTeam, err := conn.Query(
 		ctx,
 		`
-		SELECT i.user_id, u.username, u.is_active
-		FROM users u
-		JOIN users_id i ON u.user_id = i.id
-		WHERE u.team_id = $1;
+		SELECT t.reviewers_count, u.user_id, u.username, u.is_active, u.max_open_reviews
+		FROM teams t
+		LEFT JOIN users u ON u.team_id = t.id
+		WHERE t.team_name = $1
+		ORDER BY u.id;
 		`,
-		teamID,
+		teamName,
 	)
 	if err != nil {
 		return models.Team{}, fmt.Errorf("%s: %w", op, err)
 	}
-	defer getTeamMemdbers.Close()
+	defer getTeam.Close()
 
 	team := models.Team{
 		TeamName: teamName,
 	}
+	found := false
 	members := make([]models.User, 0, 8)
-	for getTeamMemdbers.Next() {
+	for getTeam.Next() {
+		var userID, username *string
+		var isActive *bool
 		var member models.User
 
-		err = getTeamMemdbers.Scan(&member.UserID, &member.Username, &member.IsActive)
+		err = getTeam.Scan(
+			&team.ReviewersCount,
+			&userID,
+			&username,
+			&isActive,
+			&member.MaxOpenReviews,
+		)
 		if err != nil {
 			return models.Team{}, fmt.Errorf("%s: %w", op, err)
 		}
+		found = true
+
+		// У команды нет членов
+		if userID == nil {
+			continue
+		}
 
+		member.UserID = *userID
+		member.Username = *username
+		member.IsActive = *isActive
 		members = append(members, member)
 	}
+	if err := getTeam.Err(); err != nil {
+		return models.Team{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Команда не найдена
+	if !found {
+		return models.Team{}, fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
 
 	team.Members = members
 	return team, nil
@@ -112,89 +124,152 @@ func (s *Storage) DeactivateTeam(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем ID команды
-	getTeamID := conn.QueryRow(
+	// Деактивируем всех пользователей данной команды и
+	// проверяем, что команда существует, в одном запросе
+	deactivate := conn.QueryRow(
 		ctx,
 		`
-		SELECT id
-		FROM teams
-		WHERE team_name = $1
+		WITH
+			team AS (
+				SELECT id
+				FROM teams
+				WHERE team_name = $1
+			),
+			deactivated AS (
+				UPDATE users
+				SET is_active = FALSE
+				WHERE team_id IN (SELECT id FROM team)
+			)
+		SELECT EXISTS (SELECT 1 FROM team);
 		`,
 		teamName,
 	)
 
-	var teamID int64
-	err := getTeamID.Scan(&teamID)
+	var exists bool
+	err := deactivate.Scan(&exists)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Деактивируем всех пользователей данной команды
-	_, err = conn.Exec(
+	// Команда не найдена
+	if !exists {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Обновляет настройки команды
+func (s *Storage) UpdateTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) error {
+	const op = "repositories.postgres.UpdateTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Обновляем количество ревьюверов
+	tag, err := conn.Exec(
 		ctx,
 		`
-		UPDATE users
-		SET is_active = FALSE
-		WHERE team_id = $1;
+		UPDATE teams
+		SET reviewers_count = $1
+		WHERE team_name = $2;
 		`,
-		teamID,
+		reviewersCount, teamName,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Команда не найдена
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
 	return nil
 }
 
-// Получает статистику пул реквестов команды
+// Получает количество пул реквестов команды по статусам
 func (s *Storage) GetTeamsPullRequests(
 	ctx context.Context,
 	teamName string,
-) (int, int, int, error) {
+) (map[models.PRStatus]int, error) {
 	const op = "repositories.postgres.GetTeamsPullRequests"
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем статусы пул реквестов команды
+	// Считаем пул реквесты команды по статусам
 	getPRStatuses, err := conn.Query(
 		ctx,
 		`
-		SELECT p.status
+		SELECT p.status, COUNT(*)
 		FROM pull_requests p
 		JOIN users u ON p.author_id = u.id
 		JOIN teams t ON u.team_id = t.id
 		WHERE t.team_name = $1
+		GROUP BY p.status
 		`,
 		teamName,
 	)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return 0, 0, 0, fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-
-		return 0, 0, 0, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer getPRStatuses.Close()
 
-	// Считаем их количество
-	var pullRequests, openPullRequests int
+	// Статусы без пул реквестов в выборку не попадут
+	statuses := make(map[models.PRStatus]int)
 	for getPRStatuses.Next() {
 		var status string
-		err := getPRStatuses.Scan(&status)
+		var count int
+		err := getPRStatuses.Scan(&status, &count)
 		if err != nil {
-			return 0, 0, 0, fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 
-		pullRequests++
-		if status == models.PULLREQUEST_OPEN {
-			openPullRequests++
+		statuses[status] = count
+	}
+
+	return statuses, nil
+}
+
+// Получает количество открытых пул реквестов каждой команды
+func (s *Storage) GetOpenPullRequestsByTeam(
+	ctx context.Context,
+) (map[string]int, error) {
+	const op = "repositories.postgres.GetOpenPullRequestsByTeam"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT t.team_name, COUNT(p.id)
+		FROM teams t
+		LEFT JOIN users u ON u.team_id = t.id
+		LEFT JOIN pull_requests p ON p.author_id = u.id AND p.status = $1
+		GROUP BY t.team_name
+		`,
+		models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	// Команды без открытых пул реквестов попадут в выборку с нулём
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		err := rows.Scan(&teamName, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+
+		counts[teamName] = count
 	}
 
-	mergedPullRequests := pullRequests - openPullRequests
-	return pullRequests, openPullRequests, mergedPullRequests, nil
+	return counts, nil
 }
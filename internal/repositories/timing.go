@@ -0,0 +1,257 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Нулевое время означает отсутствие границы
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// Получает перцентили времени до мерджа PR команды, смердженных в [from, to).
+// Свёрнутые дни, целиком попадающие в окно, берутся из merge_time_rollups
+func (s *Storage) GetMergeTimes(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+) (models.MergeTimes, error) {
+	const op = "repositories.postgres.GetMergeTimes"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	getMergeTimes := conn.QueryRow(
+		ctx,
+		`
+		WITH 
+			state AS (
+				SELECT rolled_until FROM merge_time_rollup_state
+			),
+			bounds AS (
+				SELECT COALESCE($2::timestamp, '-infinity'::timestamp) AS from_at, $3::timestamp AS to_at
+			),
+			durations AS (
+				SELECT d.seconds
+				FROM merge_time_rollups r
+				JOIN teams t ON r.team_id = t.id
+				CROSS JOIN state
+				CROSS JOIN bounds
+				CROSS JOIN LATERAL unnest(r.merge_seconds) AS d (seconds)
+				WHERE 
+					t.team_name = $1 AND
+					r.day < state.rolled_until AND
+					r.day >= bounds.from_at AND r.day + 1 <= bounds.to_at
+
+				UNION ALL
+
+				SELECT EXTRACT(EPOCH FROM p.merged_at - p.created_at)::float8
+				FROM pull_requests p
+				JOIN users u ON p.author_id = u.id
+				JOIN teams t ON u.team_id = t.id
+				CROSS JOIN state
+				CROSS JOIN bounds
+				WHERE 
+					t.team_name = $1 AND
+					p.status = $4 AND
+					p.merged_at >= bounds.from_at AND p.merged_at < bounds.to_at AND
+					NOT (
+						p.merged_at::date < state.rolled_until AND
+						p.merged_at::date >= bounds.from_at AND p.merged_at::date + 1 <= bounds.to_at
+					)
+			)
+		SELECT 
+			COUNT(*), 
+			percentile_cont(ARRAY[0.5, 0.9, 0.99]::float8[]) WITHIN GROUP (ORDER BY seconds)
+		FROM durations;
+		`,
+		teamName, nullableTime(from), to, models.PULLREQUEST_MERGED,
+	)
+
+	var mergeTimes models.MergeTimes
+	var percentiles []float64
+	err := getMergeTimes.Scan(&mergeTimes.MergedPullRequests, &percentiles)
+	if err != nil {
+		return models.MergeTimes{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Без смердженных PR перцентилей нет
+	if len(percentiles) == 3 {
+		mergeTimes.P50 = SecondsToDuration(percentiles[0])
+		mergeTimes.P90 = SecondsToDuration(percentiles[1])
+		mergeTimes.P99 = SecondsToDuration(percentiles[2])
+	}
+
+	return mergeTimes, nil
+}
+
+// Считает открытые PR команды, созданные в [from, to), по корзинам возраста
+// на момент now. Корзин на одну больше чем границ bounds
+func (s *Storage) GetOpenPullRequestAges(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	now time.Time,
+	bounds []time.Duration,
+) ([]int, error) {
+	const op = "repositories.postgres.GetOpenPullRequestAges"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	thresholds := make([]float64, len(bounds))
+	for i, bound := range bounds {
+		thresholds[i] = bound.Seconds()
+	}
+
+	// width_bucket возвращает номер первой границы, которая больше возраста
+	getAges, err := conn.Query(
+		ctx,
+		`
+		SELECT 
+			width_bucket(EXTRACT(EPOCH FROM $4::timestamp - p.created_at)::float8, $5::float8[]) AS bucket,
+			COUNT(*)
+		FROM pull_requests p
+		JOIN users u ON p.author_id = u.id
+		JOIN teams t ON u.team_id = t.id
+		WHERE 
+			t.team_name = $1 AND
+			p.status = $6 AND
+			($2::timestamp IS NULL OR p.created_at >= $2) AND 
+			p.created_at < $3
+		GROUP BY bucket;
+		`,
+		teamName, nullableTime(from), to, now, thresholds, models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getAges.Close()
+
+	// Пустые корзины в выборку не попадут
+	counts := make([]int, len(bounds)+1)
+	for getAges.Next() {
+		var bucket, count int
+		err := getAges.Scan(&bucket, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		counts[bucket] = count
+	}
+
+	return counts, nil
+}
+
+// Получает самые старые открытые PR команды, созданные в [from, to)
+func (s *Storage) GetOldestOpenPullRequests(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+	to time.Time,
+	limit int,
+) ([]models.PullRequest, error) {
+	const op = "repositories.postgres.GetOldestOpenPullRequests"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	getPullRequests, err := conn.Query(
+		ctx,
+		`
+		SELECT p.pull_request_id, p.pull_request_name, u.user_id, p.status, p.created_at
+		FROM pull_requests p
+		JOIN users u ON p.author_id = u.id
+		JOIN teams t ON u.team_id = t.id
+		WHERE 
+			t.team_name = $1 AND
+			p.status = $5 AND
+			($2::timestamp IS NULL OR p.created_at >= $2) AND 
+			p.created_at < $3
+		ORDER BY p.created_at, p.pull_request_id
+		LIMIT $4;
+		`,
+		teamName, nullableTime(from), to, limit, models.PULLREQUEST_OPEN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer getPullRequests.Close()
+
+	pullRequests := make([]models.PullRequest, 0)
+	for getPullRequests.Next() {
+		var pullRequest models.PullRequest
+		err := getPullRequests.Scan(
+			&pullRequest.ID,
+			&pullRequest.Name,
+			&pullRequest.AuthorID,
+			&pullRequest.Status,
+			&pullRequest.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	return pullRequests, nil
+}
+
+// Сворачивает время до мерджа PR, смердженных до until, по командам и дням.
+// until должен быть началом дня, уже свёрнутые дни пропускаются
+func (s *Storage) RollupMergeTimes(
+	ctx context.Context,
+	until time.Time,
+) error {
+	const op = "repositories.postgres.RollupMergeTimes"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	_, err := conn.Exec(
+		ctx,
+		`
+		WITH 
+			state AS (
+				SELECT rolled_until FROM merge_time_rollup_state
+			),
+			rolled AS (
+				INSERT INTO merge_time_rollups (team_id, day, merge_seconds)
+				SELECT 
+					u.team_id, 
+					p.merged_at::date, 
+					array_agg(EXTRACT(EPOCH FROM p.merged_at - p.created_at)::float8)
+				FROM pull_requests p
+				JOIN users u ON p.author_id = u.id
+				CROSS JOIN state
+				WHERE 
+					p.status = $2 AND
+					u.team_id IS NOT NULL AND
+					p.merged_at >= state.rolled_until AND 
+					p.merged_at < $1
+				GROUP BY u.team_id, p.merged_at::date
+				ON CONFLICT (team_id, day) DO NOTHING
+			)
+		UPDATE merge_time_rollup_state
+		SET rolled_until = $1
+		WHERE rolled_until < $1;
+		`,
+		until, models.PULLREQUEST_MERGED,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func SecondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Добавляет токен доступа по хешу и возвращает его ID
+func (s *Storage) AddToken(
+	ctx context.Context,
+	token models.APIToken,
+	tokenHash string,
+) (int64, error) {
+	const op = "repositories.postgres.AddToken"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	res := conn.QueryRow(
+		ctx,
+		`
+		INSERT INTO api_tokens (name, token_hash, role, team_name, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+		RETURNING id;
+		`,
+		token.Name, tokenHash, token.Role, token.TeamName, token.CreatedAt,
+	)
+
+	var id int64
+	err := res.Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// Возвращает неотозванный токен по его хешу
+func (s *Storage) GetTokenByHash(
+	ctx context.Context,
+	tokenHash string,
+) (models.APIToken, error) {
+	const op = "repositories.postgres.GetTokenByHash"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	res := conn.QueryRow(
+		ctx,
+		`
+		SELECT id, name, role, COALESCE(team_name, ''), created_at
+		FROM api_tokens
+		WHERE token_hash = $1 AND revoked_at IS NULL;
+		`,
+		tokenHash,
+	)
+
+	var token models.APIToken
+	err := res.Scan(
+		&token.ID,
+		&token.Name,
+		&token.Role,
+		&token.TeamName,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.APIToken{}, fmt.Errorf("%s: %w", op, ErrNotFound)
+		}
+		return models.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return token, nil
+}
+
+// Возвращает все токены, включая отозванные
+func (s *Storage) ListTokens(
+	ctx context.Context,
+) ([]models.APIToken, error) {
+	const op = "repositories.postgres.ListTokens"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT id, name, role, COALESCE(team_name, ''), created_at, revoked_at
+		FROM api_tokens
+		ORDER BY id;
+		`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	tokens := make([]models.APIToken, 0)
+	for rows.Next() {
+		var token models.APIToken
+		var revokedAt *time.Time
+		err := rows.Scan(
+			&token.ID,
+			&token.Name,
+			&token.Role,
+			&token.TeamName,
+			&token.CreatedAt,
+			&revokedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if revokedAt != nil {
+			token.RevokedAt = *revokedAt
+		}
+
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Отзывает токен. Повторный отзыв не меняет время отзыва
+func (s *Storage) RevokeToken(
+	ctx context.Context,
+	tokenID int64,
+	revokedAt time.Time,
+) error {
+	const op = "repositories.postgres.RevokeToken"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	tag, err := conn.Exec(
+		ctx,
+		`
+		UPDATE api_tokens
+		SET revoked_at = COALESCE(revoked_at, $1)
+		WHERE id = $2;
+		`,
+		revokedAt, tokenID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Токен не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
@@ -18,51 +18,21 @@ func (s *Storage) AddUser(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Вставляем ID в базу
-	_, err := conn.Exec(
-		ctx,
-		`
-		INSERT INTO users_id (user_id) 
-		VALUES ($1)
-		ON CONFLICT (user_id)
-		DO NOTHING;`,
-		user.UserID,
-	)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	// Получаем ID пользователя
-	getUserID := conn.QueryRow(
-		ctx,
-		`
-		SELECT id
-		FROM users_id
-		WHERE user_id = $1;
-		`,
-		user.UserID,
-	)
-
-	var id int64
-	err = getUserID.Scan(&id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
 	// Вставляем юзера либо обновляем его
-	_, err = conn.Exec(
+	_, err := conn.Exec(
 		ctx,
 		`
-		INSERT INTO users (user_id, username, team_id, is_active) 
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (user_id, username, team_id, is_active, max_open_reviews) 
+		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (user_id)
 		DO UPDATE SET
 			username = $2,
 			team_id = $3,
-			is_active = $4
+			is_active = $4,
+			max_open_reviews = $5
 		;
 		`,
-		id, user.Username, user.TeamID, user.IsActive,
+		user.UserID, user.Username, user.TeamID, user.IsActive, user.MaxOpenReviews,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -84,11 +54,10 @@ func (s *Storage) GetUser(
 	res := conn.QueryRow(
 		ctx,
 		`
-		SELECT u.username, t.team_name, u.is_active 
+		SELECT u.username, t.team_name, u.is_active, u.max_open_reviews
 		FROM users u
 		JOIN teams t ON u.team_id = t.id
-		JOIN users_id i ON u.user_id = i.id
-		WHERE i.user_id = $1;
+		WHERE u.user_id = $1;
 		`,
 		userID,
 	)
@@ -96,7 +65,7 @@ func (s *Storage) GetUser(
 	user := models.User{
 		UserID: userID,
 	}
-	err := res.Scan(&user.Username, &user.TeamName, &user.IsActive)
+	err := res.Scan(&user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.User{}, fmt.Errorf("%s: %w", op, ErrNotFound)
@@ -117,50 +86,48 @@ func (s *Storage) SetActive(
 
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
 
-	// Получаем числовой id
-	id, err := s.getUserID(ctx, userID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, ErrNotFound)
-		}
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
 	// Обновляем is_active у пользователя
-	_, err = conn.Exec(
+	tag, err := conn.Exec(
 		ctx,
-		`UPDATE users SET is_active = $1 WHERE id = $2`,
-		isActive, id,
+		`UPDATE users SET is_active = $1 WHERE user_id = $2`,
+		isActive, userID,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Пользователь не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
 	return nil
 }
 
-// Возвращает ID (int64) пользователя по его ID (string)
-func (s *Storage) getUserID(
+// Меняет max_open_reviews у пользователя
+func (s *Storage) SetMaxOpenReviews(
 	ctx context.Context,
 	userID string,
-) (int64, error) {
+	maxOpenReviews *int,
+) error {
+	const op = "repositories.postgres.SetMaxOpenReviews"
+
 	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
-	res := conn.QueryRow(
+
+	// Обновляем ограничение открытых ревью
+	tag, err := conn.Exec(
 		ctx,
-		`
-		SELECT u.id 
-		FROM users u 
-		JOIN users_id i ON u.user_id = i.id
-		WHERE i.user_id = $1;
-		`,
-		userID,
+		`UPDATE users SET max_open_reviews = $1 WHERE user_id = $2`,
+		maxOpenReviews, userID,
 	)
-
-	var id int64
-	err := res.Scan(&id)
 	if err != nil {
-		return 0, err
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
 
-	return id, nil
+	return nil
 }
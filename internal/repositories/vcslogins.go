@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Добавляет, либо обновляет соответствие логина пользователю
+func (s *Storage) SetVCSLogin(
+	ctx context.Context,
+	login models.VCSLogin,
+) error {
+	const op = "repositories.postgres.SetVCSLogin"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	// Сопоставляем логин, если пользователь существует
+	tag, err := conn.Exec(
+		ctx,
+		`
+		INSERT INTO vcs_logins (provider, login, user_id)
+		SELECT $1, $2, id
+		FROM users
+		WHERE user_id = $3
+		ON CONFLICT (provider, login)
+		DO UPDATE SET user_id = EXCLUDED.user_id;
+		`,
+		login.Provider, login.Login, login.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пользователь не найден
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Возвращает все соответствия логинов пользователям
+func (s *Storage) GetVCSLogins(
+	ctx context.Context,
+) ([]models.VCSLogin, error) {
+	const op = "repositories.postgres.GetVCSLogins"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT l.provider, l.login, u.user_id
+		FROM vcs_logins l
+		JOIN users u ON l.user_id = u.id
+		ORDER BY l.provider, l.login;
+		`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	logins := make([]models.VCSLogin, 0)
+	for rows.Next() {
+		var login models.VCSLogin
+		err := rows.Scan(&login.Provider, &login.Login, &login.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		logins = append(logins, login)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return logins, nil
+}
+
+// Возвращает ID пользователя по логину в системе контроля версий
+func (s *Storage) GetUserIDByVCSLogin(
+	ctx context.Context,
+	provider models.VCSProvider,
+	login string,
+) (string, error) {
+	const op = "repositories.postgres.GetUserIDByVCSLogin"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	res := conn.QueryRow(
+		ctx,
+		`
+		SELECT u.user_id
+		FROM vcs_logins l
+		JOIN users u ON l.user_id = u.id
+		WHERE l.provider = $1 AND l.login = $2;
+		`,
+		provider, login,
+	)
+
+	var userID string
+	err := res.Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, ErrNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// Удаляет соответствие логина пользователю
+func (s *Storage) DeleteVCSLogin(
+	ctx context.Context,
+	provider models.VCSProvider,
+	login string,
+) error {
+	const op = "repositories.postgres.DeleteVCSLogin"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	tag, err := conn.Exec(
+		ctx,
+		`DELETE FROM vcs_logins WHERE provider = $1 AND login = $2`,
+		provider, login,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Соответствие не найдено
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+// Возвращает логины пользователей в системе контроля версий.
+// Пользователи без сопоставленного логина пропускаются
+func (s *Storage) GetVCSLoginsByUserIDs(
+	ctx context.Context,
+	provider models.VCSProvider,
+	userIDs []string,
+) ([]string, error) {
+	const op = "repositories.postgres.GetVCSLoginsByUserIDs"
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	rows, err := conn.Query(
+		ctx,
+		`
+		SELECT l.login
+		FROM vcs_logins l
+		JOIN users u ON l.user_id = u.id
+		WHERE l.provider = $1 AND u.user_id = ANY($2)
+		ORDER BY l.login;
+		`,
+		provider, userIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	logins := make([]string, 0, len(userIDs))
+	for rows.Next() {
+		var login string
+		err := rows.Scan(&login)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		logins = append(logins, login)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return logins, nil
+}
@@ -0,0 +1,71 @@
+package requestctx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// Заголовок с ID запроса, принимается от клиента и возвращается в ответе
+	REQUEST_ID_HEADER = "X-Request-ID"
+	// Максимальная длина ID запроса от клиента, более длинные заменяются своим
+	MAX_REQUEST_ID_LENGTH = 128
+
+	// Инициатор изменений вне запросов, например фоновых задач
+	ACTOR_SYSTEM = "system"
+)
+
+type actorKey struct{}
+type requestIDKey struct{}
+
+// Кладёт в контекст инициатора изменений
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Возвращает инициатора изменений, по умолчанию - ACTOR_SYSTEM
+func Actor(ctx context.Context) string {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	if !ok || actor == "" {
+		return ACTOR_SYSTEM
+	}
+
+	return actor
+}
+
+// Кладёт в контекст ID запроса
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// Возвращает ID запроса, пустой вне запросов
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Присваивает запросу ID: берёт его из заголовка или генерирует новый
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(REQUEST_ID_HEADER)
+		if requestID == "" || len(requestID) > MAX_REQUEST_ID_LENGTH {
+			requestID = newRequestID()
+		}
+
+		c.Header(REQUEST_ID_HEADER, requestID)
+		c.Request = c.Request.WithContext(WithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read не возвращает ошибок
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,51 @@
+package requestctx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ACTOR_SYSTEM, Actor(ctx))
+
+	ctx = WithActor(ctx, "token:1:bot")
+	assert.Equal(t, "token:1:bot", Actor(ctx))
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(Middleware())
+
+	var got string
+	engine.GET("/", func(c *gin.Context) {
+		got = RequestID(c.Request.Context())
+	})
+
+	serve := func(requestID string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if requestID != "" {
+			req.Header.Set(REQUEST_ID_HEADER, requestID)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, got, w.Header().Get(REQUEST_ID_HEADER))
+		return got
+	}
+
+	// ID клиента сохраняется
+	assert.Equal(t, "req-1", serve("req-1"))
+
+	// Отсутствующий или слишком длинный ID генерируется
+	assert.Len(t, serve(""), 32)
+	assert.Len(t, serve(strings.Repeat("a", MAX_REQUEST_ID_LENGTH+1)), 32)
+}
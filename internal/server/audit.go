@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+)
+
+// (GET /audit)
+func (s *serverAPI) GetAudit(
+	c context.Context,
+	req api.GetAuditRequestObject,
+) (api.GetAuditResponseObject, error) {
+	filter := models.AuditFilter{
+		Limit: defaultPageSize,
+	}
+	if req.Params.PullRequestId != nil {
+		filter.PullRequestID = *req.Params.PullRequestId
+	}
+	if req.Params.TeamName != nil {
+		filter.TeamName = *req.Params.TeamName
+	}
+	if req.Params.UserId != nil {
+		filter.UserID = *req.Params.UserId
+	}
+	// Время событий хранится в локальном часовом поясе сервиса
+	if req.Params.From != nil {
+		filter.From = req.Params.From.Local()
+	}
+	if req.Params.To != nil {
+		filter.To = req.Params.To.Local()
+	}
+	if req.Params.Limit != nil {
+		filter.Limit = *req.Params.Limit
+	}
+
+	var cursor string
+	if req.Params.Cursor != nil {
+		cursor = *req.Params.Cursor
+	}
+
+	events, nextCursor, err := s.assign.ListAuditEvents(c, filter, cursor)
+	if errors.Is(err, prassignment.ErrInvalidCursor) ||
+		errors.Is(err, prassignment.ErrInvalidLimit) ||
+		errors.Is(err, prassignment.ErrInvalidFilter) {
+		response := api.GetAudit400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetAudit200JSONResponse{
+		Events: make([]api.AuditEvent, len(events)),
+	}
+	for i := range events {
+		event, err := convertAuditEventToApi(&events[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Events[i] = *event
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+func convertAuditEventToApi(event *models.AuditEvent) (*api.AuditEvent, error) {
+	eventRes := api.AuditEvent{
+		EventId:    event.ID,
+		Actor:      event.Actor,
+		Operation:  string(event.Operation),
+		RequestId:  event.RequestID,
+		OccurredAt: event.OccurredAt,
+	}
+	if event.PullRequestID != "" {
+		eventRes.PullRequestId = &event.PullRequestID
+	}
+	if event.TeamName != "" {
+		eventRes.TeamName = &event.TeamName
+	}
+	if event.UserID != "" {
+		eventRes.UserId = &event.UserID
+	}
+
+	// Снимки хранятся как JSON объекты
+	if len(event.Before) > 0 {
+		before := map[string]any{}
+		if err := json.Unmarshal(event.Before, &before); err != nil {
+			return nil, err
+		}
+		eventRes.Before = &before
+	}
+	if len(event.After) > 0 {
+		after := map[string]any{}
+		if err := json.Unmarshal(event.After, &after); err != nil {
+			return nil, err
+		}
+		eventRes.After = &after
+	}
+
+	return &eventRes, nil
+}
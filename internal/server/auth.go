@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/requestctx"
+	"github.com/iskanye/avito-tech-internship/internal/service/auth"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+)
+
+// (GET /auth/tokens)
+func (s *serverAPI) GetAuthTokens(
+	c context.Context,
+	req api.GetAuthTokensRequestObject,
+) (api.GetAuthTokensResponseObject, error) {
+	tokens, err := s.auth.ListTokens(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetAuthTokens200JSONResponse{
+		Tokens: make([]api.APIToken, len(tokens)),
+	}
+	for i, token := range tokens {
+		response.Tokens[i] = *convertTokenToApi(&token)
+	}
+
+	return response, nil
+}
+
+// (POST /auth/tokens)
+func (s *serverAPI) PostAuthTokens(
+	c context.Context,
+	req api.PostAuthTokensRequestObject,
+) (api.PostAuthTokensResponseObject, error) {
+	tokenReq := models.APIToken{
+		Name: req.Body.Name,
+		Role: models.Role(req.Body.Role),
+	}
+	if req.Body.TeamName != nil {
+		tokenReq.TeamName = *req.Body.TeamName
+	}
+
+	token, value, err := s.auth.CreateToken(c, tokenReq)
+	if isInvalidToken(err) {
+		response := api.PostAuthTokens400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAuthTokens201JSONResponse{
+		Token: *convertTokenToApi(&token),
+		Value: value,
+	}, nil
+}
+
+// (DELETE /auth/tokens)
+func (s *serverAPI) DeleteAuthTokens(
+	c context.Context,
+	req api.DeleteAuthTokensRequestObject,
+) (api.DeleteAuthTokensResponseObject, error) {
+	err := s.auth.RevokeToken(c, req.Params.TokenId)
+	if errors.Is(err, auth.ErrNotFound) {
+		response := api.DeleteAuthTokens404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteAuthTokens204Response{}, nil
+}
+
+// Проверяет токен из заголовка Authorization у операций, требующих его
+// по спецификации. Отказ пишется сразу в ответ, обработчик не вызывается
+func authMiddleware(a Auth) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx *gin.Context, request any) (any, error) {
+			// Операция не требует токена (например, вебхуки GitHub и GitLab)
+			if _, ok := ctx.Get(api.BearerAuthScopes); !ok {
+				return f(ctx, request)
+			}
+
+			permission, scope := authorization(request)
+
+			token, err := a.Authorize(
+				ctx,
+				bearerToken(ctx.GetHeader("Authorization")),
+				operationID,
+				permission,
+				scope,
+			)
+			if errors.Is(err, auth.ErrUnauthorized) {
+				response := api.ErrorResponse{}
+				response.Error.Code = api.UNAUTHORIZED
+				response.Error.Message = err.Error()
+
+				ctx.Header("WWW-Authenticate", "Bearer")
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, response)
+				return nil, nil
+			}
+			if errors.Is(err, auth.ErrForbidden) {
+				response := api.ErrorResponse{}
+				response.Error.Code = api.FORBIDDEN
+				response.Error.Message = err.Error()
+
+				ctx.AbortWithStatusJSON(http.StatusForbidden, response)
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			// Изменения, сделанные запросом, записываются в журнал от имени токена
+			ctx.Request = ctx.Request.WithContext(
+				requestctx.WithActor(ctx.Request.Context(), tokenActor(&token)),
+			)
+
+			return f(ctx, request)
+		}
+	}
+}
+
+// Определяет действие операции и объект, к которому оно относится.
+// Операции, не перечисленные здесь, доступны только администратору
+func authorization(
+	request any,
+) (models.Permission, models.AuthScope) {
+	switch req := request.(type) {
+	// Чтение
+	case api.GetTeamGetRequestObject,
+		api.GetTeamStatsRequestObject,
+		api.GetTeamStatsTimingRequestObject,
+		api.GetUsersGetReviewRequestObject,
+		api.GetUsersStatsRequestObject,
+		api.GetUsersStatsLeaderboardRequestObject,
+		api.GetUsersAbsenceRequestObject,
+		api.GetPullRequestGetRequestObject,
+		api.GetPullRequestHistoryRequestObject,
+		api.GetPullRequestListRequestObject:
+		return models.PERMISSION_READ, models.AuthScope{}
+
+	// Изменение команд и их пользователей
+	case api.PostTeamAddRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{TeamName: req.Body.TeamName}
+	case api.PostTeamUpdateRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{TeamName: req.Body.TeamName}
+	case api.PostTeamDeactivateRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{TeamName: req.Body.TeamName}
+	case api.PostTeamReassignRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{TeamName: req.Body.TeamName}
+	case api.PostUsersSetIsActiveRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{UserID: req.Body.UserId}
+	case api.PostUsersSetMaxOpenReviewsRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{UserID: req.Body.UserId}
+	case api.PostUsersAbsenceRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{UserID: req.Body.UserId}
+	case api.DeleteUsersAbsenceRequestObject:
+		return models.PERMISSION_TEAMS, models.AuthScope{UserID: req.Params.UserId}
+
+	// Изменение пул реквестов
+	case api.PostPullRequestCreateRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{UserID: req.Body.AuthorId}
+	case api.PostPullRequestMergeRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	case api.PostPullRequestReassignRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	case api.PostPullRequestReviewRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	case api.PostPullRequestMarkReadyRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	case api.PostPullRequestCloseRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	case api.PostPullRequestReopenRequestObject:
+		return models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: req.Body.PullRequestId}
+	}
+
+	return models.PERMISSION_ADMIN, models.AuthScope{}
+}
+
+// Инициатор изменений для журнала: token:<id>:<name>,
+// токен администратора из конфигурации - token:config
+func tokenActor(token *models.APIToken) string {
+	if token.ID == 0 {
+		return "token:config"
+	}
+
+	return fmt.Sprintf("token:%d:%s", token.ID, token.Name)
+}
+
+// Достаёт токен из заголовка вида "Bearer <token>"
+func bearerToken(header string) string {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return strings.TrimSpace(token)
+}
+
+func isInvalidToken(err error) bool {
+	return errors.Is(err, auth.ErrEmptyName) ||
+		errors.Is(err, auth.ErrUnknownRole) ||
+		errors.Is(err, auth.ErrTeamRequired) ||
+		errors.Is(err, auth.ErrTeamForbidden)
+}
+
+func convertTokenToApi(token *models.APIToken) *api.APIToken {
+	tokenRes := api.APIToken{
+		TokenId:   token.ID,
+		Name:      token.Name,
+		Role:      api.TokenRole(token.Role),
+		CreatedAt: token.CreatedAt,
+	}
+	if token.TeamName != "" {
+		tokenRes.TeamName = &token.TeamName
+	}
+	if !token.RevokedAt.IsZero() {
+		tokenRes.RevokedAt = &token.RevokedAt
+	}
+
+	return &tokenRes
+}
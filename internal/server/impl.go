@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/iskanye/avito-tech-internship/internal/models"
@@ -9,7 +10,10 @@ import (
 )
 
 type serverAPI struct {
-	assign PRAssignment
+	assign       PRAssignment
+	webhooks     Webhooks
+	integrations Integrations
+	auth         Auth
 }
 
 type PRAssignment interface {
@@ -30,6 +34,19 @@ type PRAssignment interface {
 		ctx context.Context,
 		teamName string,
 	) ([]models.Reassignment, error)
+	PreviewDeactivateTeam(
+		ctx context.Context,
+		teamName string,
+	) (models.Team, []models.Reassignment, error)
+	PreviewReassignTeam(
+		ctx context.Context,
+		teamName string,
+	) ([]models.Reassignment, error)
+	UpdateTeam(
+		ctx context.Context,
+		teamName string,
+		reviewersCount int,
+	) (models.Team, error)
 
 	// Методы пользователя
 	SetIsActive(
@@ -37,16 +54,39 @@ type PRAssignment interface {
 		userID string,
 		isActive bool,
 	) (models.User, error)
+	SetMaxOpenReviews(
+		ctx context.Context,
+		userID string,
+		maxOpenReviews *int,
+	) (models.User, error)
 	GetReview(
 		ctx context.Context,
 		userID string,
 	) ([]models.PullRequest, error)
+	AddAbsence(
+		ctx context.Context,
+		absence models.Absence,
+	) (models.Absence, error)
+	GetAbsences(
+		ctx context.Context,
+		userID string,
+	) ([]models.Absence, error)
+	DeleteAbsence(
+		ctx context.Context,
+		userID string,
+		absenceID int64,
+	) error
 
 	// Методы пул реквестов
 	CreatePullRequest(
 		ctx context.Context,
 		pullRequest models.PullRequest,
 	) (models.PullRequest, error)
+	CreatePullRequests(
+		ctx context.Context,
+		pullRequests []models.PullRequest,
+		keepReviewers bool,
+	) ([]models.PullRequestCreateResult, error)
 	MergePullRequest(
 		ctx context.Context,
 		pullRequestID string,
@@ -56,20 +96,156 @@ type PRAssignment interface {
 		pullRequestID string,
 		oldReviewerId string,
 	) (models.PullRequest, string, error)
+	ReviewPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+		reviewerID string,
+		verdict models.ReviewVerdict,
+	) (models.PullRequest, error)
+	GetPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	GetPullRequestHistory(
+		ctx context.Context,
+		pullRequestID string,
+	) ([]models.ReviewerAssignment, error)
+	ListPullRequests(
+		ctx context.Context,
+		filter models.PullRequestFilter,
+		cursor string,
+	) ([]models.PullRequest, string, error)
+	MarkReady(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	ClosePullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	ReopenPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
 
 	// Методы статистики
 	TeamStats(
 		ctx context.Context,
 		teamName string,
 	) (models.TeamStats, error)
+	TeamTimingStats(
+		ctx context.Context,
+		teamName string,
+		filter models.TimingFilter,
+	) (models.TeamTimingStats, error)
+	UserStats(
+		ctx context.Context,
+		userID string,
+		from time.Time,
+	) (models.UserStats, error)
+	TeamLeaderboard(
+		ctx context.Context,
+		teamName string,
+		from time.Time,
+	) ([]models.UserStats, error)
+
+	// Методы журнала изменений
+	ListAuditEvents(
+		ctx context.Context,
+		filter models.AuditFilter,
+		cursor string,
+	) ([]models.AuditEvent, string, error)
+}
+
+type Webhooks interface {
+	AddWebhook(
+		ctx context.Context,
+		webhook models.Webhook,
+	) (models.Webhook, error)
+	ListWebhooks(
+		ctx context.Context,
+	) ([]models.Webhook, error)
+	UpdateWebhook(
+		ctx context.Context,
+		webhookID int64,
+		update models.WebhookUpdate,
+	) (models.Webhook, error)
+	DeleteWebhook(
+		ctx context.Context,
+		webhookID int64,
+	) error
+}
+
+type Integrations interface {
+	HandleGitHubEvent(
+		ctx context.Context,
+		eventType string,
+		signature string,
+		body []byte,
+	) (models.VCSAction, models.PullRequest, error)
+	HandleGitLabEvent(
+		ctx context.Context,
+		eventType string,
+		token string,
+		body []byte,
+	) (models.VCSAction, models.PullRequest, error)
+	SetLogin(
+		ctx context.Context,
+		login models.VCSLogin,
+	) error
+	ListLogins(
+		ctx context.Context,
+	) ([]models.VCSLogin, error)
+	DeleteLogin(
+		ctx context.Context,
+		provider models.VCSProvider,
+		login string,
+	) error
+}
+
+type Auth interface {
+	Authorize(
+		ctx context.Context,
+		rawToken string,
+		operation string,
+		permission models.Permission,
+		scope models.AuthScope,
+	) (models.APIToken, error)
+	CreateToken(
+		ctx context.Context,
+		token models.APIToken,
+	) (models.APIToken, string, error)
+	ListTokens(
+		ctx context.Context,
+	) ([]models.APIToken, error)
+	RevokeToken(
+		ctx context.Context,
+		tokenID int64,
+	) error
 }
 
 // Проверка на реализацию всех методов
 var _ api.StrictServerInterface = (*serverAPI)(nil)
 
-func Register(engine *gin.Engine, prAssigment PRAssignment) {
+func Register(
+	engine *gin.Engine,
+	prAssigment PRAssignment,
+	webhooks Webhooks,
+	integrations Integrations,
+	auth Auth,
+	middlewares ...api.StrictMiddlewareFunc,
+) {
+	// Проверка токена оборачивается первой, чтобы отказы
+	// тоже попадали в метрики и трассировку
+	middlewares = append([]api.StrictMiddlewareFunc{authMiddleware(auth)}, middlewares...)
+
 	api.RegisterHandlers(engine, api.NewStrictHandler(
-		&serverAPI{assign: prAssigment},
-		[]api.StrictMiddlewareFunc{},
+		&serverAPI{
+			assign:       prAssigment,
+			webhooks:     webhooks,
+			integrations: integrations,
+			auth:         auth,
+		},
+		middlewares,
 	))
 }
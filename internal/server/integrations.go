@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/service/integrations"
+	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+)
+
+// (POST /integrations/github/webhook)
+func (s *serverAPI) PostIntegrationsGithubWebhook(
+	c context.Context,
+	req api.PostIntegrationsGithubWebhookRequestObject,
+) (api.PostIntegrationsGithubWebhookResponseObject, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := ""
+	if req.Params.XHubSignature256 != nil {
+		signature = *req.Params.XHubSignature256
+	}
+
+	action, pullRequest, err := s.integrations.HandleGitHubEvent(c, req.Params.XGitHubEvent, signature, body)
+	if status, response, ok := convertVCSError(err); ok {
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostIntegrationsGithubWebhook400JSONResponse(response), nil
+		case http.StatusUnauthorized:
+			return api.PostIntegrationsGithubWebhook401JSONResponse(response), nil
+		case http.StatusNotFound:
+			return api.PostIntegrationsGithubWebhook404JSONResponse(response), nil
+		default:
+			return api.PostIntegrationsGithubWebhook409JSONResponse(response), nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsGithubWebhook200JSONResponse(
+		convertVCSResultToApi(action, &pullRequest),
+	), nil
+}
+
+// (POST /integrations/gitlab/webhook)
+func (s *serverAPI) PostIntegrationsGitlabWebhook(
+	c context.Context,
+	req api.PostIntegrationsGitlabWebhookRequestObject,
+) (api.PostIntegrationsGitlabWebhookResponseObject, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	token := ""
+	if req.Params.XGitlabToken != nil {
+		token = *req.Params.XGitlabToken
+	}
+
+	action, pullRequest, err := s.integrations.HandleGitLabEvent(c, req.Params.XGitlabEvent, token, body)
+	if status, response, ok := convertVCSError(err); ok {
+		switch status {
+		case http.StatusBadRequest:
+			return api.PostIntegrationsGitlabWebhook400JSONResponse(response), nil
+		case http.StatusUnauthorized:
+			return api.PostIntegrationsGitlabWebhook401JSONResponse(response), nil
+		case http.StatusNotFound:
+			return api.PostIntegrationsGitlabWebhook404JSONResponse(response), nil
+		default:
+			return api.PostIntegrationsGitlabWebhook409JSONResponse(response), nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsGitlabWebhook200JSONResponse(
+		convertVCSResultToApi(action, &pullRequest),
+	), nil
+}
+
+// (GET /integrations/logins)
+func (s *serverAPI) GetIntegrationsLogins(
+	c context.Context,
+	req api.GetIntegrationsLoginsRequestObject,
+) (api.GetIntegrationsLoginsResponseObject, error) {
+	logins, err := s.integrations.ListLogins(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetIntegrationsLogins200JSONResponse{
+		Logins: make([]api.VCSLogin, len(logins)),
+	}
+	for i, login := range logins {
+		response.Logins[i] = convertVCSLoginToApi(&login)
+	}
+
+	return response, nil
+}
+
+// (POST /integrations/logins)
+func (s *serverAPI) PostIntegrationsLogins(
+	c context.Context,
+	req api.PostIntegrationsLoginsRequestObject,
+) (api.PostIntegrationsLoginsResponseObject, error) {
+	login := models.VCSLogin{
+		Provider: models.VCSProvider(req.Body.Provider),
+		Login:    req.Body.Login,
+		UserID:   req.Body.UserId,
+	}
+
+	err := s.integrations.SetLogin(c, login)
+	if errors.Is(err, integrations.ErrUnknownProvider) {
+		response := api.PostIntegrationsLogins400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, integrations.ErrNotFound) {
+		response := api.PostIntegrationsLogins404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	apiLogin := convertVCSLoginToApi(&login)
+	response := api.PostIntegrationsLogins200JSONResponse{
+		Login: &apiLogin,
+	}
+
+	return response, nil
+}
+
+// (DELETE /integrations/logins)
+func (s *serverAPI) DeleteIntegrationsLogins(
+	c context.Context,
+	req api.DeleteIntegrationsLoginsRequestObject,
+) (api.DeleteIntegrationsLoginsResponseObject, error) {
+	err := s.integrations.DeleteLogin(c, models.VCSProvider(req.Params.Provider), req.Params.Login)
+	if errors.Is(err, integrations.ErrNotFound) {
+		response := api.DeleteIntegrationsLogins404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteIntegrationsLogins204Response{}, nil
+}
+
+// Сопоставляет ошибку обработки вебхука с HTTP статусом и кодом ошибки.
+// ok = false, если ошибка не относится к клиенту
+func convertVCSError(err error) (int, api.ErrorResponse, bool) {
+	var (
+		status int
+		code   api.ErrorResponseErrorCode
+	)
+
+	switch {
+	case err == nil:
+		return 0, api.ErrorResponse{}, false
+	case errors.Is(err, integrations.ErrInvalidSignature):
+		status, code = http.StatusUnauthorized, api.INVALIDSIGNATURE
+	case errors.Is(err, integrations.ErrInvalidPayload):
+		status, code = http.StatusBadRequest, api.INVALIDARGUMENT
+	case errors.Is(err, integrations.ErrUnknownLogin),
+		errors.Is(err, prassignment.ErrNotFound):
+		status, code = http.StatusNotFound, api.NOTFOUND
+	case errors.Is(err, prassignment.ErrPRExists):
+		status, code = http.StatusConflict, api.PREXISTS
+	case errors.Is(err, prassignment.ErrPRMerged):
+		status, code = http.StatusConflict, api.PRMERGED
+	case errors.Is(err, prassignment.ErrPRClosed):
+		status, code = http.StatusConflict, api.PRCLOSED
+	case errors.Is(err, prassignment.ErrPRDraft):
+		status, code = http.StatusConflict, api.PRDRAFT
+	case errors.Is(err, prassignment.ErrNotApproved):
+		status, code = http.StatusConflict, api.NOTAPPROVED
+	default:
+		return 0, api.ErrorResponse{}, false
+	}
+
+	response := api.ErrorResponse{}
+	response.Error.Code = code
+	response.Error.Message = err.Error()
+	return status, response, true
+}
+
+func convertVCSResultToApi(
+	action models.VCSAction,
+	pullRequest *models.PullRequest,
+) api.VCSWebhookResult {
+	result := api.VCSWebhookResult{
+		Action: api.VCSWebhookResultAction(action),
+	}
+	if action != models.VCS_ACTION_IGNORED {
+		result.Pr = convertPullRequestToApi(pullRequest)
+	}
+
+	return result
+}
+
+func convertVCSLoginToApi(login *models.VCSLogin) api.VCSLogin {
+	return api.VCSLogin{
+		Provider: api.VCSProvider(login.Provider),
+		Login:    login.Login,
+		UserId:   login.UserID,
+	}
+}
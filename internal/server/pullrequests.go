@@ -2,7 +2,11 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
@@ -15,13 +19,7 @@ func (s *serverAPI) PostPullRequestCreate(
 	c context.Context,
 	req api.PostPullRequestCreateRequestObject,
 ) (api.PostPullRequestCreateResponseObject, error) {
-	pullRequest := models.PullRequest{
-		ID:        req.Body.PullRequestId,
-		Name:      req.Body.PullRequestName,
-		AuthorID:  req.Body.AuthorId,
-		Status:    models.PULLREQUEST_OPEN,
-		CreatedAt: time.Now().Truncate(time.Second),
-	}
+	pullRequest := newPullRequest(req.Body.PullRequestId, req.Body.PullRequestName, req.Body.AuthorId, req.Body.Draft)
 
 	pullRequest, err := s.assign.CreatePullRequest(c, pullRequest)
 	if errors.Is(err, prassignment.ErrNotFound) {
@@ -41,20 +39,167 @@ func (s *serverAPI) PostPullRequestCreate(
 	}
 
 	response := api.PostPullRequestCreate201JSONResponse{
-		Pr: &api.PullRequest{
-			PullRequestId:     pullRequest.ID,
-			PullRequestName:   pullRequest.Name,
-			AuthorId:          pullRequest.AuthorID,
-			Status:            api.PullRequestStatus(pullRequest.Status),
-			AssignedReviewers: pullRequest.AssignedReviewers,
-			CreatedAt:         &pullRequest.CreatedAt,
-			MergedAt:          &pullRequest.MergedAt,
-		},
+		Pr: convertPullRequestToApi(&pullRequest),
+	}
+
+	return response, nil
+}
+
+// Сколько пул реквестов создаётся одной транзакцией в /pullRequest/bulkCreate
+const BULK_CREATE_BATCH_SIZE = 100
+
+// (POST /pullRequest/bulkCreate)
+func (s *serverAPI) PostPullRequestBulkCreate(
+	c context.Context,
+	req api.PostPullRequestBulkCreateRequestObject,
+) (api.PostPullRequestBulkCreateResponseObject, error) {
+	keepReviewers := req.Params.KeepReviewers != nil && *req.Params.KeepReviewers
+
+	// Поток читается и создаётся пачками уже при отправке ответа
+	if req.Body != nil {
+		response := bulkCreateStream{
+			ctx:           c,
+			assign:        s.assign,
+			body:          req.Body,
+			keepReviewers: keepReviewers,
+		}
+		return response, nil
+	}
+	if req.JSONBody == nil {
+		response := api.PostPullRequestBulkCreate400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = "unsupported content type"
+		return response, nil
+	}
+
+	response := api.PostPullRequestBulkCreate200JSONResponse{
+		Results: make([]api.BulkCreateResult, 0, len(*req.JSONBody)),
+	}
+	var err error
+	for batch := range slices.Chunk(*req.JSONBody, BULK_CREATE_BATCH_SIZE) {
+		pullRequests := make([]models.PullRequest, len(batch))
+		for i := range batch {
+			pullRequests[i] = convertBulkPullRequestFromApi(&batch[i])
+		}
+
+		var results []models.PullRequestCreateResult
+		results, err = s.assign.CreatePullRequests(c, pullRequests, keepReviewers)
+		if err != nil {
+			break
+		}
+
+		for i := range results {
+			response.Results = append(response.Results, convertCreateResultToApi(&results[i]))
+		}
+	}
+
+	// Уже созданные пачки остаются, а PR неудавшейся и следующих пачек
+	// получают итог ERROR
+	if err != nil {
+		for _, item := range (*req.JSONBody)[len(response.Results):] {
+			response.Results = append(response.Results, convertCreateErrorToApi(item.PullRequestId, err))
+		}
 	}
 
 	return response, nil
 }
 
+// Ответ /pullRequest/bulkCreate на поток application/x-ndjson. Пул реквесты
+// читаются построчно, а итоги каждой пачки отправляются сразу после её создания
+type bulkCreateStream struct {
+	ctx           context.Context
+	assign        PRAssignment
+	body          io.Reader
+	keepReviewers bool
+}
+
+func (r bulkCreateStream) VisitPostPullRequestBulkCreateResponse(w http.ResponseWriter) error {
+	// Без этого HTTP/1.1 сервер закрывает тело запроса после первой отправленной пачки
+	err := http.NewResponseController(w).EnableFullDuplex()
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	decoder := json.NewDecoder(r.body)
+	encoder := json.NewEncoder(w)
+
+	// Ошибка, из-за которой пачку не удалось создать. После неё поток
+	// дальше не читается
+	var createErr error
+
+	// Создаёт накопленную пачку и отправляет её итоги. Возвращает ошибку,
+	// только если итоги не удалось отправить
+	batch := make([]models.PullRequest, 0, BULK_CREATE_BATCH_SIZE)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		results, err := r.assign.CreatePullRequests(r.ctx, batch, r.keepReviewers)
+		if err != nil {
+			createErr = err
+			for i := range batch {
+				err := encoder.Encode(convertCreateErrorToApi(batch[i].ID, createErr))
+				if err != nil {
+					return err
+				}
+			}
+
+			// Итог без ID сообщает, что поток прерван
+			return encoder.Encode(convertCreateErrorToApi("", createErr))
+		}
+		batch = batch[:0]
+
+		for i := range results {
+			err := encoder.Encode(convertCreateResultToApi(&results[i]))
+			if err != nil {
+				return err
+			}
+		}
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		return nil
+	}
+
+	for {
+		var item api.BulkPullRequest
+		err := decoder.Decode(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		// После некорректной строки поток дальше не читается
+		if err != nil {
+			flushErr := flush()
+			if flushErr != nil || createErr != nil {
+				return flushErr
+			}
+
+			result := api.BulkCreateResult{Status: api.BulkINVALID}
+			result.Error = &bulkCreateError{
+				Code:    string(api.INVALIDARGUMENT),
+				Message: err.Error(),
+			}
+			return encoder.Encode(result)
+		}
+
+		batch = append(batch, convertBulkPullRequestFromApi(&item))
+		if len(batch) == BULK_CREATE_BATCH_SIZE {
+			err := flush()
+			if err != nil || createErr != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
 // (POST /pullRequest/merge)
 func (s *serverAPI) PostPullRequestMerge(
 	c context.Context,
@@ -67,20 +212,30 @@ func (s *serverAPI) PostPullRequestMerge(
 		response.Error.Message = err.Error()
 		return response, nil
 	}
+	if errors.Is(err, prassignment.ErrNotApproved) {
+		response := api.PostPullRequestMerge409JSONResponse{}
+		response.Error.Code = api.NOTAPPROVED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRDraft) {
+		response := api.PostPullRequestMerge409JSONResponse{}
+		response.Error.Code = api.PRDRAFT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRClosed) {
+		response := api.PostPullRequestMerge409JSONResponse{}
+		response.Error.Code = api.PRCLOSED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	response := api.PostPullRequestMerge200JSONResponse{
-		Pr: &api.PullRequest{
-			PullRequestId:     pullRequest.ID,
-			PullRequestName:   pullRequest.Name,
-			AuthorId:          pullRequest.AuthorID,
-			Status:            api.PullRequestStatus(pullRequest.Status),
-			AssignedReviewers: pullRequest.AssignedReviewers,
-			CreatedAt:         &pullRequest.CreatedAt,
-			MergedAt:          &pullRequest.MergedAt,
-		},
+		Pr: convertPullRequestToApi(&pullRequest),
 	}
 
 	return response, nil
@@ -104,6 +259,12 @@ func (s *serverAPI) PostPullRequestReassign(
 		response.Error.Message = err.Error()
 		return response, nil
 	}
+	if errors.Is(err, prassignment.ErrPRClosed) {
+		response := api.PostPullRequestReassign409JSONResponse{}
+		response.Error.Code = api.PRCLOSED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
 	if errors.Is(err, prassignment.ErrNotAssigned) {
 		response := api.PostPullRequestReassign409JSONResponse{}
 		response.Error.Code = api.NOTASSIGNED
@@ -121,17 +282,397 @@ func (s *serverAPI) PostPullRequestReassign(
 	}
 
 	response := api.PostPullRequestReassign200JSONResponse{
-		Pr: api.PullRequest{
-			PullRequestId:     pullRequest.ID,
-			PullRequestName:   pullRequest.Name,
-			AuthorId:          pullRequest.AuthorID,
-			Status:            api.PullRequestStatus(pullRequest.Status),
-			AssignedReviewers: pullRequest.AssignedReviewers,
-			CreatedAt:         &pullRequest.CreatedAt,
-			MergedAt:          &pullRequest.MergedAt,
-		},
+		Pr:         *convertPullRequestToApi(&pullRequest),
 		ReplacedBy: replacedBy,
 	}
 
 	return response, nil
 }
+
+// (POST /pullRequest/review)
+func (s *serverAPI) PostPullRequestReview(
+	c context.Context,
+	req api.PostPullRequestReviewRequestObject,
+) (api.PostPullRequestReviewResponseObject, error) {
+	pullRequest, err := s.assign.ReviewPullRequest(
+		c,
+		req.Body.PullRequestId,
+		req.Body.ReviewerId,
+		models.ReviewVerdict(req.Body.Verdict),
+	)
+	if errors.Is(err, prassignment.ErrInvalidVerdict) {
+		response := api.PostPullRequestReview400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostPullRequestReview404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRMerged) {
+		response := api.PostPullRequestReview409JSONResponse{}
+		response.Error.Code = api.PRMERGED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRClosed) {
+		response := api.PostPullRequestReview409JSONResponse{}
+		response.Error.Code = api.PRCLOSED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotAssigned) {
+		response := api.PostPullRequestReview409JSONResponse{}
+		response.Error.Code = api.NOTASSIGNED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostPullRequestReview200JSONResponse{
+		Pr: *convertPullRequestToApi(&pullRequest),
+	}
+
+	return response, nil
+}
+
+// (GET /pullRequest/get)
+func (s *serverAPI) GetPullRequestGet(
+	c context.Context,
+	req api.GetPullRequestGetRequestObject,
+) (api.GetPullRequestGetResponseObject, error) {
+	pullRequest, err := s.assign.GetPullRequest(c, req.Params.PullRequestId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetPullRequestGet404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := (api.GetPullRequestGet200JSONResponse)(*convertPullRequestToApi(&pullRequest))
+	return response, nil
+}
+
+// (GET /pullRequest/history)
+func (s *serverAPI) GetPullRequestHistory(
+	c context.Context,
+	req api.GetPullRequestHistoryRequestObject,
+) (api.GetPullRequestHistoryResponseObject, error) {
+	history, err := s.assign.GetPullRequestHistory(c, req.Params.PullRequestId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetPullRequestHistory404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetPullRequestHistory200JSONResponse{
+		PullRequestId: req.Params.PullRequestId,
+		Assignments:   make([]api.ReviewerAssignment, len(history)),
+	}
+	for i := range history {
+		response.Assignments[i] = *convertAssignmentToApi(&history[i])
+	}
+
+	return response, nil
+}
+
+// Количество PR на странице по умолчанию
+const defaultPageSize = 20
+
+// (GET /pullRequest/list)
+func (s *serverAPI) GetPullRequestList(
+	c context.Context,
+	req api.GetPullRequestListRequestObject,
+) (api.GetPullRequestListResponseObject, error) {
+	filter := models.PullRequestFilter{
+		SortBy:     models.PULLREQUEST_SORT_CREATED_AT,
+		Descending: true,
+		Limit:      defaultPageSize,
+	}
+	if req.Params.AuthorId != nil {
+		filter.AuthorID = *req.Params.AuthorId
+	}
+	if req.Params.ReviewerId != nil {
+		filter.ReviewerID = *req.Params.ReviewerId
+	}
+	if req.Params.TeamName != nil {
+		filter.TeamName = *req.Params.TeamName
+	}
+	if req.Params.Status != nil {
+		filter.Status = models.PRStatus(*req.Params.Status)
+	}
+	// Время PR хранится в локальном часовом поясе сервиса
+	if req.Params.CreatedFrom != nil {
+		filter.CreatedFrom = req.Params.CreatedFrom.Local()
+	}
+	if req.Params.CreatedTo != nil {
+		filter.CreatedTo = req.Params.CreatedTo.Local()
+	}
+	if req.Params.MergedFrom != nil {
+		filter.MergedFrom = req.Params.MergedFrom.Local()
+	}
+	if req.Params.MergedTo != nil {
+		filter.MergedTo = req.Params.MergedTo.Local()
+	}
+	if req.Params.SortBy != nil {
+		filter.SortBy = models.PRSortField(*req.Params.SortBy)
+	}
+	if req.Params.Order != nil {
+		filter.Descending = *req.Params.Order != api.Asc
+	}
+	if req.Params.Limit != nil {
+		filter.Limit = *req.Params.Limit
+	}
+
+	var cursor string
+	if req.Params.Cursor != nil {
+		cursor = *req.Params.Cursor
+	}
+
+	pullRequests, nextCursor, err := s.assign.ListPullRequests(c, filter, cursor)
+	if errors.Is(err, prassignment.ErrInvalidCursor) ||
+		errors.Is(err, prassignment.ErrInvalidLimit) ||
+		errors.Is(err, prassignment.ErrInvalidFilter) {
+		response := api.GetPullRequestList400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetPullRequestList200JSONResponse{
+		PullRequests: make([]api.PullRequest, len(pullRequests)),
+	}
+	for i := range pullRequests {
+		response.PullRequests[i] = *convertPullRequestToApi(&pullRequests[i])
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+// (POST /pullRequest/markReady)
+func (s *serverAPI) PostPullRequestMarkReady(
+	c context.Context,
+	req api.PostPullRequestMarkReadyRequestObject,
+) (api.PostPullRequestMarkReadyResponseObject, error) {
+	pullRequest, err := s.assign.MarkReady(c, req.Body.PullRequestId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostPullRequestMarkReady404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRMerged) {
+		response := api.PostPullRequestMarkReady409JSONResponse{}
+		response.Error.Code = api.PRMERGED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRClosed) {
+		response := api.PostPullRequestMarkReady409JSONResponse{}
+		response.Error.Code = api.PRCLOSED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostPullRequestMarkReady200JSONResponse{
+		Pr: *convertPullRequestToApi(&pullRequest),
+	}
+
+	return response, nil
+}
+
+// (POST /pullRequest/close)
+func (s *serverAPI) PostPullRequestClose(
+	c context.Context,
+	req api.PostPullRequestCloseRequestObject,
+) (api.PostPullRequestCloseResponseObject, error) {
+	pullRequest, err := s.assign.ClosePullRequest(c, req.Body.PullRequestId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostPullRequestClose404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRMerged) {
+		response := api.PostPullRequestClose409JSONResponse{}
+		response.Error.Code = api.PRMERGED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostPullRequestClose200JSONResponse{
+		Pr: *convertPullRequestToApi(&pullRequest),
+	}
+
+	return response, nil
+}
+
+// (POST /pullRequest/reopen)
+func (s *serverAPI) PostPullRequestReopen(
+	c context.Context,
+	req api.PostPullRequestReopenRequestObject,
+) (api.PostPullRequestReopenResponseObject, error) {
+	pullRequest, err := s.assign.ReopenPullRequest(c, req.Body.PullRequestId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostPullRequestReopen404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrPRMerged) {
+		response := api.PostPullRequestReopen409JSONResponse{}
+		response.Error.Code = api.PRMERGED
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostPullRequestReopen200JSONResponse{
+		Pr: *convertPullRequestToApi(&pullRequest),
+	}
+
+	return response, nil
+}
+
+// Пул реквест, создаваемый через API, в статусе OPEN или DRAFT
+func newPullRequest(
+	pullRequestID string,
+	pullRequestName string,
+	authorID string,
+	draft *bool,
+) models.PullRequest {
+	pullRequest := models.PullRequest{
+		ID:        pullRequestID,
+		Name:      pullRequestName,
+		AuthorID:  authorID,
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now().Truncate(time.Second),
+	}
+	if draft != nil && *draft {
+		pullRequest.Status = models.PULLREQUEST_DRAFT
+	}
+
+	return pullRequest
+}
+
+func convertBulkPullRequestFromApi(item *api.BulkPullRequest) models.PullRequest {
+	pullRequest := newPullRequest(item.PullRequestId, item.PullRequestName, item.AuthorId, item.Draft)
+	if item.AssignedReviewers != nil {
+		pullRequest.AssignedReviewers = *item.AssignedReviewers
+	}
+
+	return pullRequest
+}
+
+// Причина, по которой пул реквест не создан
+type bulkCreateError = struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func convertCreateResultToApi(result *models.PullRequestCreateResult) api.BulkCreateResult {
+	resultRes := api.BulkCreateResult{
+		PullRequestId: result.PullRequest.ID,
+	}
+
+	var code api.ErrorResponseErrorCode
+	switch {
+	case result.Err == nil:
+		resultRes.Status = api.BulkCREATED
+		resultRes.Pr = convertPullRequestToApi(&result.PullRequest)
+		return resultRes
+	case errors.Is(result.Err, prassignment.ErrPRExists):
+		resultRes.Status, code = api.BulkCONFLICT, api.PREXISTS
+	case errors.Is(result.Err, prassignment.ErrNotFound):
+		resultRes.Status, code = api.BulkNOTFOUND, api.NOTFOUND
+	case errors.Is(result.Err, prassignment.ErrInvalidPullRequest),
+		errors.Is(result.Err, prassignment.ErrInvalidReviewers):
+		resultRes.Status, code = api.BulkINVALID, api.INVALIDARGUMENT
+	default:
+		return convertCreateErrorToApi(result.PullRequest.ID, result.Err)
+	}
+
+	resultRes.Error = &bulkCreateError{
+		Code:    string(code),
+		Message: result.Err.Error(),
+	}
+	return resultRes
+}
+
+// Итог пул реквеста, не созданного из-за внутренней ошибки
+func convertCreateErrorToApi(pullRequestID string, err error) api.BulkCreateResult {
+	return api.BulkCreateResult{
+		PullRequestId: pullRequestID,
+		Status:        api.BulkERROR,
+		Error: &bulkCreateError{
+			Code:    string(api.INTERNAL),
+			Message: err.Error(),
+		},
+	}
+}
+
+func convertPullRequestToApi(pullRequest *models.PullRequest) *api.PullRequest {
+	pullRequestRes := api.PullRequest{
+		PullRequestId:     pullRequest.ID,
+		PullRequestName:   pullRequest.Name,
+		AuthorId:          pullRequest.AuthorID,
+		Status:            api.PullRequestStatus(pullRequest.Status),
+		AssignedReviewers: pullRequest.AssignedReviewers,
+		CreatedAt:         &pullRequest.CreatedAt,
+		MergedAt:          &pullRequest.MergedAt,
+		ReviewersMissing:  &pullRequest.ReviewersMissing,
+	}
+
+	if pullRequest.Reviews != nil {
+		reviews := make([]api.Review, len(pullRequest.Reviews))
+		for i, review := range pullRequest.Reviews {
+			reviews[i].ReviewerId = review.ReviewerID
+			reviews[i].Verdict = api.ReviewVerdict(review.Verdict)
+		}
+		pullRequestRes.Reviews = &reviews
+	}
+
+	return &pullRequestRes
+}
+
+func convertAssignmentToApi(assignment *models.ReviewerAssignment) *api.ReviewerAssignment {
+	assignmentRes := api.ReviewerAssignment{
+		ReviewerId: assignment.ReviewerID,
+		Reason:     api.ReviewerAssignmentReason(assignment.Reason),
+		AssignedAt: assignment.AssignedAt,
+	}
+	if assignment.Verdict != "" {
+		assignmentRes.Verdict = &assignment.Verdict
+	}
+	if !assignment.UnassignedAt.IsZero() {
+		assignmentRes.UnassignedAt = &assignment.UnassignedAt
+	}
+
+	return &assignmentRes
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
 	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
@@ -15,13 +16,18 @@ func (s *serverAPI) PostTeamAdd(
 	req api.PostTeamAddRequestObject,
 ) (api.PostTeamAddResponseObject, error) {
 	teamReq := models.Team{
-		TeamName: req.Body.TeamName,
-		Members:  make([]models.User, len(req.Body.Members)),
+		TeamName:       req.Body.TeamName,
+		ReviewersCount: models.DEFAULT_REVIEWERS_COUNT,
+		Members:        make([]models.User, len(req.Body.Members)),
+	}
+	if req.Body.ReviewersCount != nil {
+		teamReq.ReviewersCount = *req.Body.ReviewersCount
 	}
 	for i, member := range req.Body.Members {
 		teamReq.Members[i].UserID = member.UserId
 		teamReq.Members[i].Username = member.Username
 		teamReq.Members[i].IsActive = member.IsActive
+		teamReq.Members[i].MaxOpenReviews = member.MaxOpenReviews
 	}
 
 	team, err := s.assign.AddTeam(c, teamReq)
@@ -31,6 +37,13 @@ func (s *serverAPI) PostTeamAdd(
 		response.Error.Message = err.Error()
 		return response, nil
 	}
+	if errors.Is(err, prassignment.ErrInvalidReviewersCount) ||
+		errors.Is(err, prassignment.ErrInvalidMaxOpenReviews) {
+		response := api.PostTeamAdd400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -63,12 +76,47 @@ func (s *serverAPI) GetTeamGet(
 	return response, nil
 }
 
+// (POST /team/update)
+func (s *serverAPI) PostTeamUpdate(
+	c context.Context,
+	req api.PostTeamUpdateRequestObject,
+) (api.PostTeamUpdateResponseObject, error) {
+	team, err := s.assign.UpdateTeam(c, req.Body.TeamName, req.Body.ReviewersCount)
+	if errors.Is(err, prassignment.ErrInvalidReviewersCount) {
+		response := api.PostTeamUpdate400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostTeamUpdate404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	teamResp := convertTeamToApi(&team)
+	response := (api.PostTeamUpdate200JSONResponse)(*teamResp)
+	return response, nil
+}
+
 // (POST /team/deactivate)
 func (s *serverAPI) PostTeamDeactivate(
 	c context.Context,
 	req api.PostTeamDeactivateRequestObject,
 ) (api.PostTeamDeactivateResponseObject, error) {
-	team, err := s.assign.DeactivateTeam(c, req.Body.TeamName)
+	var team models.Team
+	var reassignments []models.Reassignment
+	var err error
+	dryRun := req.Body.DryRun != nil && *req.Body.DryRun
+	if dryRun {
+		team, reassignments, err = s.assign.PreviewDeactivateTeam(c, req.Body.TeamName)
+	} else {
+		team, err = s.assign.DeactivateTeam(c, req.Body.TeamName)
+	}
 	if errors.Is(err, prassignment.ErrNotFound) {
 		response := api.PostTeamDeactivate404JSONResponse{}
 		response.Error.Code = api.NOTFOUND
@@ -80,7 +128,20 @@ func (s *serverAPI) PostTeamDeactivate(
 	}
 
 	teamResp := convertTeamToApi(&team)
-	response := (api.PostTeamDeactivate200JSONResponse)(*teamResp)
+	response := api.PostTeamDeactivate200JSONResponse{
+		TeamName:       teamResp.TeamName,
+		ReviewersCount: teamResp.ReviewersCount,
+		Members:        teamResp.Members,
+	}
+
+	// Переназначения показываются только при пробном запуске
+	if dryRun {
+		reassignmentsResp := convertReassignmentsToApi(reassignments)
+		unresolved := models.UnresolvedPullRequests(reassignments)
+		response.Reassignments = &reassignmentsResp
+		response.UnresolvedPullRequests = &unresolved
+	}
+
 	return response, nil
 }
 
@@ -89,7 +150,13 @@ func (s *serverAPI) PostTeamReassign(
 	c context.Context,
 	req api.PostTeamReassignRequestObject,
 ) (api.PostTeamReassignResponseObject, error) {
-	reassignments, err := s.assign.ReassignTeam(c, req.Body.TeamName)
+	var reassignments []models.Reassignment
+	var err error
+	if req.Body.DryRun != nil && *req.Body.DryRun {
+		reassignments, err = s.assign.PreviewReassignTeam(c, req.Body.TeamName)
+	} else {
+		reassignments, err = s.assign.ReassignTeam(c, req.Body.TeamName)
+	}
 	if errors.Is(err, prassignment.ErrNotFound) {
 		response := api.PostTeamReassign404JSONResponse{}
 		response.Error.Code = api.NOTFOUND
@@ -101,12 +168,8 @@ func (s *serverAPI) PostTeamReassign(
 	}
 
 	response := api.PostTeamReassign200JSONResponse{
-		Reassignments: make([]api.Reassignment, len(reassignments)),
-	}
-
-	for i := range reassignments {
-		response.Reassignments[i].OldReviewer = reassignments[i].OldReviewer
-		response.Reassignments[i].NewReviewer = reassignments[i].NewReviewer
+		Reassignments:          convertReassignmentsToApi(reassignments),
+		UnresolvedPullRequests: models.UnresolvedPullRequests(reassignments),
 	}
 
 	return response, nil
@@ -134,21 +197,120 @@ func (s *serverAPI) GetTeamStats(
 	response.ActiveUsers = stats.ActiveUsers
 	response.InactiveUsers = stats.InactiveUsers
 	response.PullRequests = stats.PullRequests
+	response.DraftPullRequests = stats.DraftPullRequests
 	response.OpenPullRequests = stats.OpenPullRequests
 	response.MergedPullRequests = stats.MergedPullRequests
+	response.ClosedPullRequests = stats.ClosedPullRequests
+	return response, nil
+}
+
+// Количество самых старых открытых PR по умолчанию
+const defaultOldestLimit = 5
+
+// (GET /team/stats/timing)
+func (s *serverAPI) GetTeamStatsTiming(
+	c context.Context,
+	req api.GetTeamStatsTimingRequestObject,
+) (api.GetTeamStatsTimingResponseObject, error) {
+	filter := models.TimingFilter{
+		OldestLimit: defaultOldestLimit,
+	}
+	// Время PR хранится в локальном часовом поясе сервиса
+	if req.Params.From != nil {
+		filter.From = req.Params.From.Local()
+	}
+	if req.Params.To != nil {
+		filter.To = req.Params.To.Local()
+	}
+	if req.Params.Limit != nil {
+		filter.OldestLimit = *req.Params.Limit
+	}
+
+	stats, err := s.assign.TeamTimingStats(c, req.Params.TeamName, filter)
+	if errors.Is(err, prassignment.ErrInvalidLimit) ||
+		errors.Is(err, prassignment.ErrInvalidFilter) {
+		response := api.GetTeamStatsTiming400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetTeamStatsTiming404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetTeamStatsTiming200JSONResponse{
+		TeamName:               stats.TeamName,
+		To:                     stats.To,
+		MergedPullRequests:     stats.MergeTimes.MergedPullRequests,
+		OpenPullRequests:       stats.OpenPullRequests,
+		OpenAgeDistribution:    make([]api.AgeBucket, len(stats.OpenAge)),
+		OldestOpenPullRequests: make([]api.OpenPullRequestAge, len(stats.OldestOpen)),
+	}
+	if !stats.From.IsZero() {
+		response.From = &stats.From
+	}
+
+	// Без смердженных PR перцентилей нет
+	if stats.MergeTimes.MergedPullRequests > 0 {
+		p50 := stats.MergeTimes.P50.Seconds()
+		p90 := stats.MergeTimes.P90.Seconds()
+		p99 := stats.MergeTimes.P99.Seconds()
+		response.TimeToMerge.P50Seconds = &p50
+		response.TimeToMerge.P90Seconds = &p90
+		response.TimeToMerge.P99Seconds = &p99
+	}
+
+	for i, bucket := range stats.OpenAge {
+		response.OpenAgeDistribution[i].Bucket = api.AgeBucketBucket(bucket.Name)
+		response.OpenAgeDistribution[i].Count = bucket.Count
+	}
+
+	now := time.Now()
+	for i, pullRequest := range stats.OldestOpen {
+		response.OldestOpenPullRequests[i] = api.OpenPullRequestAge{
+			PullRequestId:   pullRequest.ID,
+			PullRequestName: pullRequest.Name,
+			AuthorId:        pullRequest.AuthorID,
+			CreatedAt:       pullRequest.CreatedAt,
+			AgeSeconds:      now.Sub(pullRequest.CreatedAt).Seconds(),
+		}
+	}
+
 	return response, nil
 }
 
 func convertTeamToApi(team *models.Team) *api.Team {
 	teamRes := api.Team{
-		TeamName: team.TeamName,
-		Members:  make([]api.TeamMember, len(team.Members)),
+		TeamName:       team.TeamName,
+		ReviewersCount: &team.ReviewersCount,
+		Members:        make([]api.TeamMember, len(team.Members)),
 	}
 	for i, member := range team.Members {
 		teamRes.Members[i].UserId = member.UserID
 		teamRes.Members[i].Username = member.Username
 		teamRes.Members[i].IsActive = member.IsActive
+		teamRes.Members[i].MaxOpenReviews = member.MaxOpenReviews
 	}
 
 	return &teamRes
 }
+
+func convertReassignmentsToApi(reassignments []models.Reassignment) []api.Reassignment {
+	reassignmentsRes := make([]api.Reassignment, len(reassignments))
+	for i := range reassignments {
+		reassignmentsRes[i].PullRequestId = reassignments[i].PullRequestID
+		reassignmentsRes[i].OldReviewer = reassignments[i].OldReviewer
+		reassignmentsRes[i].Outcome = api.ReassignmentOutcome(reassignments[i].Outcome)
+		if reassignments[i].NewReviewer != "" {
+			reassignmentsRes[i].NewReviewer = &reassignments[i].NewReviewer
+		}
+	}
+
+	return reassignmentsRes
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/iskanye/avito-tech-internship/internal/models"
 	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
 	"github.com/iskanye/avito-tech-internship/pkg/api"
 )
@@ -55,11 +57,212 @@ func (s *serverAPI) PostUsersSetIsActive(
 	}
 
 	response := api.PostUsersSetIsActive200JSONResponse{}
-	response.User = &api.User{
-		UserId:   user.UserID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+	response.User = convertUserToApi(&user)
+	return response, nil
+}
+
+// (POST /users/setMaxOpenReviews)
+func (s *serverAPI) PostUsersSetMaxOpenReviews(
+	c context.Context,
+	req api.PostUsersSetMaxOpenReviewsRequestObject,
+) (api.PostUsersSetMaxOpenReviewsResponseObject, error) {
+	user, err := s.assign.SetMaxOpenReviews(c, req.Body.UserId, req.Body.MaxOpenReviews)
+	if errors.Is(err, prassignment.ErrInvalidMaxOpenReviews) {
+		response := api.PostUsersSetMaxOpenReviews400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostUsersSetMaxOpenReviews404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostUsersSetMaxOpenReviews200JSONResponse{}
+	response.User = convertUserToApi(&user)
+	return response, nil
+}
+
+// (GET /users/absence)
+func (s *serverAPI) GetUsersAbsence(
+	c context.Context,
+	req api.GetUsersAbsenceRequestObject,
+) (api.GetUsersAbsenceResponseObject, error) {
+	absences, err := s.assign.GetAbsences(c, req.Params.UserId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetUsersAbsence404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetUsersAbsence200JSONResponse{
+		Absences: make([]api.Absence, len(absences)),
+		UserId:   req.Params.UserId,
+	}
+	for i, absence := range absences {
+		response.Absences[i] = *convertAbsenceToApi(&absence)
+	}
+
+	return response, nil
+}
+
+// (POST /users/absence)
+func (s *serverAPI) PostUsersAbsence(
+	c context.Context,
+	req api.PostUsersAbsenceRequestObject,
+) (api.PostUsersAbsenceResponseObject, error) {
+	// Время хранится без часового пояса, поэтому приводим его к локальному
+	absence := models.Absence{
+		UserID:   req.Body.UserId,
+		StartsAt: req.Body.StartsAt.Local(),
+		EndsAt:   req.Body.EndsAt.Local(),
+	}
+	if req.Body.Reason != nil {
+		absence.Reason = *req.Body.Reason
+	}
+
+	absence, err := s.assign.AddAbsence(c, absence)
+	if errors.Is(err, prassignment.ErrInvalidAbsence) {
+		response := api.PostUsersAbsence400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.PostUsersAbsence404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostUsersAbsence201JSONResponse{}
+	response.Absence = convertAbsenceToApi(&absence)
+	return response, nil
+}
+
+// (DELETE /users/absence)
+func (s *serverAPI) DeleteUsersAbsence(
+	c context.Context,
+	req api.DeleteUsersAbsenceRequestObject,
+) (api.DeleteUsersAbsenceResponseObject, error) {
+	err := s.assign.DeleteAbsence(c, req.Params.UserId, req.Params.AbsenceId)
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.DeleteUsersAbsence404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	return api.DeleteUsersAbsence204Response{}, nil
+}
+
+// (GET /users/stats)
+func (s *serverAPI) GetUsersStats(
+	c context.Context,
+	req api.GetUsersStatsRequestObject,
+) (api.GetUsersStatsResponseObject, error) {
+	stats, err := s.assign.UserStats(c, req.Params.UserId, statsFrom(req.Params.From))
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetUsersStats404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := (api.GetUsersStats200JSONResponse)(*convertUserStatsToApi(&stats))
 	return response, nil
 }
+
+// (GET /users/stats/leaderboard)
+func (s *serverAPI) GetUsersStatsLeaderboard(
+	c context.Context,
+	req api.GetUsersStatsLeaderboardRequestObject,
+) (api.GetUsersStatsLeaderboardResponseObject, error) {
+	leaderboard, err := s.assign.TeamLeaderboard(c, req.Params.TeamName, statsFrom(req.Params.From))
+	if errors.Is(err, prassignment.ErrNotFound) {
+		response := api.GetUsersStatsLeaderboard404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetUsersStatsLeaderboard200JSONResponse{
+		TeamName: req.Params.TeamName,
+		Users:    make([]api.UserStats, len(leaderboard)),
+	}
+	for i := range leaderboard {
+		response.Users[i] = *convertUserStatsToApi(&leaderboard[i])
+	}
+
+	return response, nil
+}
+
+// Время начала статистики, хранится в локальном часовом поясе сервиса
+func statsFrom(from *time.Time) time.Time {
+	if from == nil {
+		return time.Time{}
+	}
+
+	return from.Local()
+}
+
+func convertUserToApi(user *models.User) *api.User {
+	return &api.User{
+		UserId:         user.UserID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+}
+
+func convertAbsenceToApi(absence *models.Absence) *api.Absence {
+	return &api.Absence{
+		AbsenceId: absence.ID,
+		UserId:    absence.UserID,
+		StartsAt:  absence.StartsAt,
+		EndsAt:    absence.EndsAt,
+		Reason:    absence.Reason,
+	}
+}
+
+func convertUserStatsToApi(stats *models.UserStats) *api.UserStats {
+	statsRes := api.UserStats{
+		UserId:               stats.UserID,
+		Username:             stats.Username,
+		TeamName:             stats.TeamName,
+		ReviewsAssigned:      stats.ReviewsAssigned,
+		OpenReviews:          stats.OpenReviews,
+		MergedReviews:        stats.MergedReviews,
+		PullRequestsAuthored: stats.PullRequestsAuthored,
+		ReassignedAway:       stats.ReassignedAway,
+	}
+	// Без ревью смердженных PR медианы нет
+	if stats.MergedReviews > 0 {
+		median := stats.MedianTimeToMerge.Seconds()
+		statsRes.MedianTimeToMergeSeconds = &median
+	}
+
+	return &statsRes
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/service/webhooks"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+)
+
+// (GET /webhooks)
+func (s *serverAPI) GetWebhooks(
+	c context.Context,
+	req api.GetWebhooksRequestObject,
+) (api.GetWebhooksResponseObject, error) {
+	hooks, err := s.webhooks.ListWebhooks(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GetWebhooks200JSONResponse{
+		Webhooks: make([]api.Webhook, len(hooks)),
+	}
+	for i, webhook := range hooks {
+		response.Webhooks[i] = *convertWebhookToApi(&webhook)
+	}
+
+	return response, nil
+}
+
+// (POST /webhooks)
+func (s *serverAPI) PostWebhooks(
+	c context.Context,
+	req api.PostWebhooksRequestObject,
+) (api.PostWebhooksResponseObject, error) {
+	webhookReq := models.Webhook{
+		URL:      req.Body.Url,
+		Secret:   req.Body.Secret,
+		IsActive: true,
+	}
+	if req.Body.Events != nil {
+		webhookReq.Events = convertEventsFromApi(*req.Body.Events)
+	}
+	if req.Body.IsActive != nil {
+		webhookReq.IsActive = *req.Body.IsActive
+	}
+
+	webhook, err := s.webhooks.AddWebhook(c, webhookReq)
+	if isInvalidWebhook(err) {
+		response := api.PostWebhooks400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PostWebhooks201JSONResponse{}
+	response.Webhook = convertWebhookToApi(&webhook)
+	return response, nil
+}
+
+// (PATCH /webhooks)
+func (s *serverAPI) PatchWebhooks(
+	c context.Context,
+	req api.PatchWebhooksRequestObject,
+) (api.PatchWebhooksResponseObject, error) {
+	update := models.WebhookUpdate{
+		URL:      req.Body.Url,
+		Secret:   req.Body.Secret,
+		IsActive: req.Body.IsActive,
+	}
+	if req.Body.Events != nil {
+		events := convertEventsFromApi(*req.Body.Events)
+		update.Events = &events
+	}
+
+	webhook, err := s.webhooks.UpdateWebhook(c, req.Body.WebhookId, update)
+	if isInvalidWebhook(err) {
+		response := api.PatchWebhooks400JSONResponse{}
+		response.Error.Code = api.INVALIDARGUMENT
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if errors.Is(err, webhooks.ErrNotFound) {
+		response := api.PatchWebhooks404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PatchWebhooks200JSONResponse{}
+	response.Webhook = convertWebhookToApi(&webhook)
+	return response, nil
+}
+
+// (DELETE /webhooks)
+func (s *serverAPI) DeleteWebhooks(
+	c context.Context,
+	req api.DeleteWebhooksRequestObject,
+) (api.DeleteWebhooksResponseObject, error) {
+	err := s.webhooks.DeleteWebhook(c, req.Params.WebhookId)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		response := api.DeleteWebhooks404JSONResponse{}
+		response.Error.Code = api.NOTFOUND
+		response.Error.Message = err.Error()
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteWebhooks204Response{}, nil
+}
+
+func isInvalidWebhook(err error) bool {
+	return errors.Is(err, webhooks.ErrInvalidURL) ||
+		errors.Is(err, webhooks.ErrEmptySecret) ||
+		errors.Is(err, webhooks.ErrUnknownEvent)
+}
+
+func convertEventsFromApi(events []api.WebhookEvent) []models.EventType {
+	res := make([]models.EventType, len(events))
+	for i, event := range events {
+		res[i] = models.EventType(event)
+	}
+
+	return res
+}
+
+func convertWebhookToApi(webhook *models.Webhook) *api.Webhook {
+	webhookRes := api.Webhook{
+		WebhookId: webhook.ID,
+		Url:       webhook.URL,
+		Events:    make([]api.WebhookEvent, len(webhook.Events)),
+		IsActive:  webhook.IsActive,
+		CreatedAt: webhook.CreatedAt,
+	}
+	for i, event := range webhook.Events {
+		webhookRes.Events[i] = api.WebhookEvent(event)
+	}
+
+	return &webhookRes
+}
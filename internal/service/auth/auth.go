@@ -0,0 +1,424 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/service/auth")
+
+// Префикс выпускаемых токенов, по нему токен легко найти в логах и секретах
+const TOKEN_PREFIX = "pra_"
+
+// Права ролей. Права team-maintainer на PR и команды
+// ограничены его командой
+var permissions = map[models.Role][]models.Permission{
+	models.ROLE_ADMIN: {
+		models.PERMISSION_READ,
+		models.PERMISSION_PULL_REQUESTS,
+		models.PERMISSION_TEAMS,
+		models.PERMISSION_ADMIN,
+	},
+	models.ROLE_TEAM_MAINTAINER: {
+		models.PERMISSION_READ,
+		models.PERMISSION_PULL_REQUESTS,
+		models.PERMISSION_TEAMS,
+	},
+	models.ROLE_BOT: {
+		models.PERMISSION_READ,
+		models.PERMISSION_PULL_REQUESTS,
+	},
+	models.ROLE_READ_ONLY: {
+		models.PERMISSION_READ,
+	},
+}
+
+// Проверяет токены доступа к API и управляет ими
+type Auth struct {
+	log *slog.Logger
+
+	// Токен администратора из конфигурации (пустой - не используется)
+	adminToken string
+
+	// Объекты для взаимодействия с токенами
+	tokenCreator  TokenCreator
+	tokenProvider TokenProvider
+	tokenModifier TokenModifier
+
+	// Объекты для определения команды, к которой относится запрос
+	userProvider UserProvider
+	prProvider   PRProvider
+}
+
+// Интерфейсы для работы сервиса
+
+type TokenCreator interface {
+	AddToken(
+		ctx context.Context,
+		token models.APIToken,
+		tokenHash string,
+	) (int64, error)
+}
+
+type TokenProvider interface {
+	GetTokenByHash(
+		ctx context.Context,
+		tokenHash string,
+	) (models.APIToken, error)
+	ListTokens(
+		ctx context.Context,
+	) ([]models.APIToken, error)
+}
+
+type TokenModifier interface {
+	RevokeToken(
+		ctx context.Context,
+		tokenID int64,
+		revokedAt time.Time,
+	) error
+}
+
+type UserProvider interface {
+	GetUser(
+		ctx context.Context,
+		userID string,
+	) (models.User, error)
+}
+
+type PRProvider interface {
+	GetPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+}
+
+func New(
+	log *slog.Logger,
+	adminToken string,
+
+	tokenCreator TokenCreator,
+	tokenProvider TokenProvider,
+	tokenModifier TokenModifier,
+
+	userProvider UserProvider,
+	prProvider PRProvider,
+) *Auth {
+	return &Auth{
+		log:        log,
+		adminToken: adminToken,
+
+		tokenCreator:  tokenCreator,
+		tokenProvider: tokenProvider,
+		tokenModifier: tokenModifier,
+
+		userProvider: userProvider,
+		prProvider:   prProvider,
+	}
+}
+
+// Проверяет, что токен действителен и его роль разрешает действие
+// над объектом. Каждый отказ пишется в лог
+func (a *Auth) Authorize(
+	ctx context.Context,
+	rawToken string,
+	operation string,
+	permission models.Permission,
+	scope models.AuthScope,
+) (models.APIToken, error) {
+	const op = "service.Auth.Authorize"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("operation", operation),
+		slog.String("permission", string(permission)),
+	)
+
+	// Находим токен
+	token, err := a.authenticate(ctx, rawToken)
+	if err != nil {
+		if errors.Is(err, ErrUnauthorized) {
+			log.Warn("Request rejected",
+				slog.String("reason", err.Error()),
+			)
+
+			return models.APIToken{}, err
+		}
+
+		log.Error("Failed to authenticate token",
+			slog.String("err", err.Error()),
+		)
+
+		return models.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(
+		slog.Int64("token_id", token.ID),
+		slog.String("token_name", token.Name),
+		slog.String("role", string(token.Role)),
+	)
+
+	// Проверяем права роли
+	if !slices.Contains(permissions[token.Role], permission) {
+		log.Warn("Request rejected",
+			slog.String("reason", "role has no permission"),
+		)
+
+		return models.APIToken{}, ErrForbidden
+	}
+
+	// Права team-maintainer на изменения ограничены его командой
+	if token.Role == models.ROLE_TEAM_MAINTAINER && permission != models.PERMISSION_READ {
+		teamName, err := a.scopeTeam(ctx, scope)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			log.Error("Failed to resolve team of request",
+				slog.String("err", err.Error()),
+			)
+
+			return models.APIToken{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if teamName == "" || teamName != token.TeamName {
+			log.Warn("Request rejected",
+				slog.String("reason", "request is outside of token team"),
+				slog.String("token_team", token.TeamName),
+				slog.String("request_team", teamName),
+			)
+
+			return models.APIToken{}, ErrForbidden
+		}
+	}
+
+	return token, nil
+}
+
+// Выпускает токен. Сам токен возвращается только здесь,
+// в БД сохраняется его хеш
+func (a *Auth) CreateToken(
+	ctx context.Context,
+	token models.APIToken,
+) (models.APIToken, string, error) {
+	const op = "service.Auth.CreateToken"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("name", token.Name),
+		slog.String("role", string(token.Role)),
+		slog.String("team_name", token.TeamName),
+	)
+
+	log.Info("Attempting to create token")
+
+	// Проверяем токен
+	err := validateToken(&token)
+	if err != nil {
+		log.Error("Invalid token",
+			slog.String("err", err.Error()),
+		)
+
+		return models.APIToken{}, "", err
+	}
+
+	rawToken, err := generateToken()
+	if err != nil {
+		log.Error("Failed to generate token",
+			slog.String("err", err.Error()),
+		)
+
+		return models.APIToken{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	token.CreatedAt = time.Now().Truncate(time.Second)
+
+	// Сохраняем хеш токена
+	id, err := a.tokenCreator.AddToken(ctx, token, hashToken(rawToken))
+	if err != nil {
+		log.Error("Failed to add token",
+			slog.String("err", err.Error()),
+		)
+
+		return models.APIToken{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+	token.ID = id
+
+	log.Info("Created token successfully",
+		slog.Int64("token_id", id),
+	)
+
+	return token, rawToken, nil
+}
+
+// Получает все токены
+func (a *Auth) ListTokens(
+	ctx context.Context,
+) ([]models.APIToken, error) {
+	const op = "service.Auth.ListTokens"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+	)
+
+	log.Info("Attempting to list tokens")
+
+	tokens, err := a.tokenProvider.ListTokens(ctx)
+	if err != nil {
+		log.Error("Failed to list tokens",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Listed tokens successfully")
+
+	return tokens, nil
+}
+
+// Отзывает токен (идемпотентная операция)
+func (a *Auth) RevokeToken(
+	ctx context.Context,
+	tokenID int64,
+) error {
+	const op = "service.Auth.RevokeToken"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.Int64("token_id", tokenID),
+	)
+
+	log.Info("Attempting to revoke token")
+
+	err := a.tokenModifier.RevokeToken(ctx, tokenID, time.Now().Truncate(time.Second))
+	if err != nil {
+		log.Error("Failed to revoke token",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Revoked token successfully")
+
+	return nil
+}
+
+// Находит токен по его значению
+func (a *Auth) authenticate(
+	ctx context.Context,
+	rawToken string,
+) (models.APIToken, error) {
+	if rawToken == "" {
+		return models.APIToken{}, ErrUnauthorized
+	}
+
+	// Токен администратора из конфигурации не хранится в БД
+	if a.adminToken != "" &&
+		subtle.ConstantTimeCompare([]byte(rawToken), []byte(a.adminToken)) == 1 {
+		return models.APIToken{Name: "config", Role: models.ROLE_ADMIN}, nil
+	}
+
+	token, err := a.tokenProvider.GetTokenByHash(ctx, hashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.APIToken{}, ErrUnauthorized
+		}
+
+		return models.APIToken{}, err
+	}
+
+	return token, nil
+}
+
+// Определяет команду, к которой относится объект запроса
+func (a *Auth) scopeTeam(
+	ctx context.Context,
+	scope models.AuthScope,
+) (string, error) {
+	if scope.TeamName != "" {
+		return scope.TeamName, nil
+	}
+
+	userID := scope.UserID
+	if scope.PullRequestID != "" {
+		pr, err := a.prProvider.GetPullRequest(ctx, scope.PullRequestID)
+		if err != nil {
+			return "", err
+		}
+		userID = pr.AuthorID
+	}
+	if userID == "" {
+		return "", nil
+	}
+
+	user, err := a.userProvider.GetUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return user.TeamName, nil
+}
+
+// Проверяет имя, роль и команду токена
+func validateToken(token *models.APIToken) error {
+	if token.Name == "" {
+		return ErrEmptyName
+	}
+
+	if !slices.Contains(models.Roles, token.Role) {
+		return ErrUnknownRole
+	}
+
+	if token.Role == models.ROLE_TEAM_MAINTAINER && token.TeamName == "" {
+		return ErrTeamRequired
+	}
+	if token.Role != models.ROLE_TEAM_MAINTAINER && token.TeamName != "" {
+		return ErrTeamForbidden
+	}
+
+	return nil
+}
+
+// Генерирует случайный токен из 256 бит
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Токены случайные и длинные, поэтому достаточно SHA-256 без соли
+func hashToken(rawToken string) string {
+	sum := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(sum[:])
+}
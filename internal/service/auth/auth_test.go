@@ -0,0 +1,263 @@
+package auth
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminToken = "admin-token"
+
+// Хранилище токенов, пользователей и PR в памяти
+type storage struct {
+	tokens map[string]models.APIToken // По хешу токена
+	users  map[string]string          // Команда пользователя
+	prs    map[string]string          // Автор PR
+}
+
+func newStorage() *storage {
+	return &storage{
+		tokens: map[string]models.APIToken{},
+		users:  map[string]string{"u1": "backend", "u2": "frontend"},
+		prs:    map[string]string{"pr-1": "u1", "pr-2": "u2"},
+	}
+}
+
+func (s *storage) AddToken(
+	_ context.Context,
+	token models.APIToken,
+	tokenHash string,
+) (int64, error) {
+	token.ID = int64(len(s.tokens) + 1)
+	s.tokens[tokenHash] = token
+	return token.ID, nil
+}
+
+func (s *storage) GetTokenByHash(
+	_ context.Context,
+	tokenHash string,
+) (models.APIToken, error) {
+	token, ok := s.tokens[tokenHash]
+	if !ok || !token.RevokedAt.IsZero() {
+		return models.APIToken{}, repositories.ErrNotFound
+	}
+	return token, nil
+}
+
+func (s *storage) ListTokens(context.Context) ([]models.APIToken, error) {
+	tokens := make([]models.APIToken, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (s *storage) RevokeToken(
+	_ context.Context,
+	tokenID int64,
+	revokedAt time.Time,
+) error {
+	for hash, token := range s.tokens {
+		if token.ID == tokenID {
+			token.RevokedAt = revokedAt
+			s.tokens[hash] = token
+			return nil
+		}
+	}
+	return repositories.ErrNotFound
+}
+
+func (s *storage) GetUser(
+	_ context.Context,
+	userID string,
+) (models.User, error) {
+	teamName, ok := s.users[userID]
+	if !ok {
+		return models.User{}, repositories.ErrNotFound
+	}
+	return models.User{UserID: userID, TeamName: teamName}, nil
+}
+
+func (s *storage) GetPullRequest(
+	_ context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	authorID, ok := s.prs[pullRequestID]
+	if !ok {
+		return models.PullRequest{}, repositories.ErrNotFound
+	}
+	return models.PullRequest{ID: pullRequestID, AuthorID: authorID}, nil
+}
+
+func newAuth() (*Auth, *storage) {
+	s := newStorage()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	return New(log, adminToken, s, s, s, s, s), s
+}
+
+func TestAuthorize_AdminToken(t *testing.T) {
+	a, _ := newAuth()
+
+	token, err := a.Authorize(
+		context.Background(), adminToken, "op",
+		models.PERMISSION_ADMIN, models.AuthScope{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.ROLE_ADMIN, token.Role)
+}
+
+func TestAuthorize_Unauthorized(t *testing.T) {
+	a, _ := newAuth()
+
+	for _, rawToken := range []string{"", "unknown", adminToken + "x"} {
+		_, err := a.Authorize(
+			context.Background(), rawToken, "op",
+			models.PERMISSION_READ, models.AuthScope{},
+		)
+		assert.ErrorIs(t, err, ErrUnauthorized, rawToken)
+	}
+}
+
+func TestAuthorize_Roles(t *testing.T) {
+	tests := []struct {
+		role       models.Role
+		permission models.Permission
+		allowed    bool
+	}{
+		{models.ROLE_READ_ONLY, models.PERMISSION_READ, true},
+		{models.ROLE_READ_ONLY, models.PERMISSION_PULL_REQUESTS, false},
+		{models.ROLE_READ_ONLY, models.PERMISSION_TEAMS, false},
+		{models.ROLE_READ_ONLY, models.PERMISSION_ADMIN, false},
+		{models.ROLE_BOT, models.PERMISSION_READ, true},
+		{models.ROLE_BOT, models.PERMISSION_PULL_REQUESTS, true},
+		{models.ROLE_BOT, models.PERMISSION_TEAMS, false},
+		{models.ROLE_BOT, models.PERMISSION_ADMIN, false},
+		{models.ROLE_ADMIN, models.PERMISSION_TEAMS, true},
+		{models.ROLE_ADMIN, models.PERMISSION_ADMIN, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+string(tt.permission), func(t *testing.T) {
+			a, _ := newAuth()
+			ctx := context.Background()
+
+			_, rawToken, err := a.CreateToken(ctx, models.APIToken{Name: "token", Role: tt.role})
+			require.NoError(t, err)
+
+			_, err = a.Authorize(ctx, rawToken, "op", tt.permission, models.AuthScope{PullRequestID: "pr-1"})
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestAuthorize_TeamMaintainer(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission models.Permission
+		scope      models.AuthScope
+		allowed    bool
+	}{
+		{"read other team", models.PERMISSION_READ, models.AuthScope{TeamName: "frontend"}, true},
+		{"own team", models.PERMISSION_TEAMS, models.AuthScope{TeamName: "backend"}, true},
+		{"other team", models.PERMISSION_TEAMS, models.AuthScope{TeamName: "frontend"}, false},
+		{"own user", models.PERMISSION_TEAMS, models.AuthScope{UserID: "u1"}, true},
+		{"other user", models.PERMISSION_TEAMS, models.AuthScope{UserID: "u2"}, false},
+		{"unknown user", models.PERMISSION_TEAMS, models.AuthScope{UserID: "u3"}, false},
+		{"own PR", models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: "pr-1"}, true},
+		{"other PR", models.PERMISSION_PULL_REQUESTS, models.AuthScope{PullRequestID: "pr-2"}, false},
+		{"no scope", models.PERMISSION_PULL_REQUESTS, models.AuthScope{}, false},
+		{"admin", models.PERMISSION_ADMIN, models.AuthScope{TeamName: "backend"}, false},
+	}
+
+	a, _ := newAuth()
+	ctx := context.Background()
+
+	_, rawToken, err := a.CreateToken(ctx, models.APIToken{
+		Name:     "maintainer",
+		Role:     models.ROLE_TEAM_MAINTAINER,
+		TeamName: "backend",
+	})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.Authorize(ctx, rawToken, "op", tt.permission, tt.scope)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestCreateToken_StoresHash(t *testing.T) {
+	a, s := newAuth()
+	ctx := context.Background()
+
+	token, rawToken, err := a.CreateToken(ctx, models.APIToken{Name: "bot", Role: models.ROLE_BOT})
+	require.NoError(t, err)
+	assert.NotZero(t, token.ID)
+	assert.True(t, strings.HasPrefix(rawToken, TOKEN_PREFIX))
+
+	// Значение токена не хранится
+	for hash := range s.tokens {
+		assert.NotEqual(t, rawToken, hash)
+		assert.NotContains(t, hash, strings.TrimPrefix(rawToken, TOKEN_PREFIX))
+	}
+
+	// Токены не повторяются
+	_, otherToken, err := a.CreateToken(ctx, models.APIToken{Name: "bot", Role: models.ROLE_BOT})
+	require.NoError(t, err)
+	assert.NotEqual(t, rawToken, otherToken)
+}
+
+func TestCreateToken_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token models.APIToken
+		err   error
+	}{
+		{"empty name", models.APIToken{Role: models.ROLE_BOT}, ErrEmptyName},
+		{"unknown role", models.APIToken{Name: "t", Role: "root"}, ErrUnknownRole},
+		{"maintainer without team", models.APIToken{Name: "t", Role: models.ROLE_TEAM_MAINTAINER}, ErrTeamRequired},
+		{"bot with team", models.APIToken{Name: "t", Role: models.ROLE_BOT, TeamName: "backend"}, ErrTeamForbidden},
+	}
+
+	a, _ := newAuth()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := a.CreateToken(context.Background(), tt.token)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	a, _ := newAuth()
+	ctx := context.Background()
+
+	token, rawToken, err := a.CreateToken(ctx, models.APIToken{Name: "bot", Role: models.ROLE_BOT})
+	require.NoError(t, err)
+
+	require.NoError(t, a.RevokeToken(ctx, token.ID))
+
+	_, err = a.Authorize(ctx, rawToken, "op", models.PERMISSION_READ, models.AuthScope{})
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	assert.ErrorIs(t, a.RevokeToken(ctx, token.ID+1), ErrNotFound)
+}
@@ -0,0 +1,13 @@
+package auth
+
+import "errors"
+
+var (
+	ErrNotFound      = errors.New("resource not found")
+	ErrUnauthorized  = errors.New("missing or invalid API token")
+	ErrForbidden     = errors.New("API token is not allowed to perform this operation")
+	ErrEmptyName     = errors.New("token name must not be empty")
+	ErrUnknownRole   = errors.New("unknown token role")
+	ErrTeamRequired  = errors.New("team-maintainer token requires team_name")
+	ErrTeamForbidden = errors.New("team_name is allowed only for team-maintainer tokens")
+)
@@ -0,0 +1,11 @@
+package integrations
+
+import "errors"
+
+var (
+	ErrNotFound         = errors.New("resource not found")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+	ErrUnknownLogin     = errors.New("VCS login is not mapped to a user")
+	ErrUnknownProvider  = errors.New("unknown VCS provider")
+)
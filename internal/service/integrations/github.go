@@ -0,0 +1,102 @@
+package integrations
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Заголовки запроса GitHub
+const (
+	GITHUB_EVENT_HEADER     = "X-GitHub-Event"
+	GITHUB_SIGNATURE_HEADER = "X-Hub-Signature-256"
+)
+
+// Тип события GitHub с изменениями пул реквеста
+const githubPullRequestEvent = "pull_request"
+
+// Нужная сервису часть события pull_request
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// Проверяет подпись sha256=<hex> тела запроса секретом вебхука
+func verifyGitHubSignature(secret string, body []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+
+	hexSum, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	sum, err := hex.DecodeString(hexSum)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(sum, mac.Sum(nil))
+}
+
+// Разбирает событие GitHub. События, не относящиеся к сервису,
+// возвращаются с действием VCS_ACTION_IGNORED
+func parseGitHubEvent(eventType string, body []byte) (models.VCSPullRequestEvent, error) {
+	event := models.VCSPullRequestEvent{
+		Provider: models.VCS_GITHUB,
+		Action:   models.VCS_ACTION_IGNORED,
+	}
+	if eventType != githubPullRequestEvent {
+		return event, nil
+	}
+
+	var payload githubPullRequestPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil || payload.Repository.FullName == "" || payload.PullRequest.Number == 0 {
+		return models.VCSPullRequestEvent{}, ErrInvalidPayload
+	}
+
+	// PR идентифицируется как owner/repo#number
+	event.PullRequestID = models.VCSPullRequestID(
+		models.VCS_GITHUB,
+		payload.Repository.FullName,
+		payload.PullRequest.Number,
+	)
+	event.Name = payload.PullRequest.Title
+	event.AuthorLogin = payload.PullRequest.User.Login
+	event.Draft = payload.PullRequest.Draft
+
+	switch payload.Action {
+	case "opened":
+		event.Action = models.VCS_ACTION_OPENED
+	case "ready_for_review":
+		event.Action = models.VCS_ACTION_READY
+	case "reopened":
+		event.Action = models.VCS_ACTION_REOPENED
+	case "closed":
+		event.Action = models.VCS_ACTION_CLOSED
+		if payload.PullRequest.Merged {
+			event.Action = models.VCS_ACTION_MERGED
+		}
+	}
+
+	return event, nil
+}
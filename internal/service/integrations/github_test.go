@@ -0,0 +1,78 @@
+package integrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Читает записанное событие из testdata
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	return body
+}
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	body := fixture(t, "github_opened.json")
+	signature := sign(githubSecret, body)
+
+	assert.True(t, verifyGitHubSignature(githubSecret, body, signature))
+	assert.False(t, verifyGitHubSignature("other", body, signature))
+	assert.False(t, verifyGitHubSignature(githubSecret, append(body, ' '), signature))
+	assert.False(t, verifyGitHubSignature(githubSecret, body, signature[len("sha256="):]))
+	assert.False(t, verifyGitHubSignature(githubSecret, body, "sha256=zz"))
+	// Без настроенного секрета запросы не принимаются
+	assert.False(t, verifyGitHubSignature("", body, sign("", body)))
+}
+
+func TestParseGitHubEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  models.VCSAction
+		prID    string
+		draft   bool
+	}{
+		{"github_opened.json", models.VCS_ACTION_OPENED, "octo-org/backend#42", false},
+		{"github_opened_draft.json", models.VCS_ACTION_OPENED, "octo-org/backend#43", true},
+		{"github_ready_for_review.json", models.VCS_ACTION_READY, "octo-org/backend#43", false},
+		{"github_closed_merged.json", models.VCS_ACTION_MERGED, "octo-org/backend#42", false},
+		{"github_closed.json", models.VCS_ACTION_CLOSED, "octo-org/backend#42", false},
+		{"github_reopened.json", models.VCS_ACTION_REOPENED, "octo-org/backend#42", false},
+		{"github_labeled.json", models.VCS_ACTION_IGNORED, "octo-org/backend#42", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := parseGitHubEvent(githubPullRequestEvent, fixture(t, tt.fixture))
+			require.NoError(t, err)
+
+			assert.Equal(t, models.VCS_GITHUB, event.Provider)
+			assert.Equal(t, tt.action, event.Action)
+			assert.Equal(t, tt.prID, event.PullRequestID)
+			assert.Equal(t, "octocat", event.AuthorLogin)
+			assert.Equal(t, tt.draft, event.Draft)
+		})
+	}
+}
+
+func TestParseGitHubEvent_Ignored(t *testing.T) {
+	event, err := parseGitHubEvent("ping", []byte(`{"zen":"Keep it logically awesome."}`))
+	require.NoError(t, err)
+	assert.Equal(t, models.VCS_ACTION_IGNORED, event.Action)
+}
+
+func TestParseGitHubEvent_Invalid(t *testing.T) {
+	_, err := parseGitHubEvent(githubPullRequestEvent, []byte(`{"action":`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+
+	_, err = parseGitHubEvent(githubPullRequestEvent, []byte(`{"action":"opened"}`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
@@ -0,0 +1,110 @@
+package integrations
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Заголовки запроса GitLab
+const (
+	GITLAB_EVENT_HEADER = "X-Gitlab-Event"
+	GITLAB_TOKEN_HEADER = "X-Gitlab-Token"
+)
+
+// Тип события GitLab с изменениями merge request
+const gitlabMergeRequestEvent = "Merge Request Hook"
+
+// Нужная сервису часть события merge request
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int    `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID      int    `json:"iid"`
+		AuthorID int    `json:"author_id"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		Draft    bool   `json:"draft"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+// Проверяет секретный токен вебхука. GitLab не подписывает тело,
+// а передаёт токен как есть
+func verifyGitLabToken(secret string, token string) bool {
+	if secret == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+// Разбирает событие GitLab. События, не относящиеся к сервису,
+// возвращаются с действием VCS_ACTION_IGNORED
+func parseGitLabEvent(eventType string, body []byte) (models.VCSPullRequestEvent, error) {
+	event := models.VCSPullRequestEvent{
+		Provider: models.VCS_GITLAB,
+		Action:   models.VCS_ACTION_IGNORED,
+	}
+	if eventType != gitlabMergeRequestEvent {
+		return event, nil
+	}
+
+	var payload gitlabMergeRequestPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil ||
+		payload.ObjectKind != "merge_request" ||
+		payload.Project.PathWithNamespace == "" ||
+		payload.ObjectAttributes.IID == 0 {
+		return models.VCSPullRequestEvent{}, ErrInvalidPayload
+	}
+
+	// MR идентифицируется как group/project!iid
+	event.PullRequestID = models.VCSPullRequestID(
+		models.VCS_GITLAB,
+		payload.Project.PathWithNamespace,
+		payload.ObjectAttributes.IID,
+	)
+	event.Name = payload.ObjectAttributes.Title
+	event.Draft = payload.ObjectAttributes.Draft
+
+	// user - тот, кто вызвал событие, а автора MR GitLab передаёт только
+	// числовым ID. Логин из события подходит, если событие вызвал сам автор
+	authorID := payload.ObjectAttributes.AuthorID
+	if authorID == 0 || authorID == payload.User.ID {
+		event.AuthorLogin = payload.User.Username
+	} else {
+		event.AuthorExternalID = authorID
+	}
+
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		event.Action = models.VCS_ACTION_OPENED
+	case "merge":
+		event.Action = models.VCS_ACTION_MERGED
+	case "close":
+		event.Action = models.VCS_ACTION_CLOSED
+	case "reopen":
+		event.Action = models.VCS_ACTION_REOPENED
+	case "update":
+		// MR готов к ревью, когда с него сняли пометку draft
+		draft := payload.Changes.Draft
+		if draft != nil && draft.Previous && !draft.Current {
+			event.Action = models.VCS_ACTION_READY
+		}
+	}
+
+	return event, nil
+}
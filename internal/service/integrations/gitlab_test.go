@@ -0,0 +1,65 @@
+package integrations
+
+import (
+	"testing"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyGitLabToken(t *testing.T) {
+	assert.True(t, verifyGitLabToken(gitlabToken, gitlabToken))
+	assert.False(t, verifyGitLabToken(gitlabToken, "other"))
+	assert.False(t, verifyGitLabToken(gitlabToken, ""))
+	// Без настроенного токена запросы не принимаются
+	assert.False(t, verifyGitLabToken("", ""))
+}
+
+func TestParseGitLabEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  models.VCSAction
+	}{
+		{"gitlab_open.json", models.VCS_ACTION_OPENED},
+		{"gitlab_update_ready.json", models.VCS_ACTION_READY},
+		{"gitlab_update_title.json", models.VCS_ACTION_IGNORED},
+		{"gitlab_merge.json", models.VCS_ACTION_MERGED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := parseGitLabEvent(gitlabMergeRequestEvent, fixture(t, tt.fixture))
+			require.NoError(t, err)
+
+			assert.Equal(t, models.VCS_GITLAB, event.Provider)
+			assert.Equal(t, tt.action, event.Action)
+			assert.Equal(t, "gitlabhq/gitlab-test!1", event.PullRequestID)
+		})
+	}
+}
+
+func TestParseGitLabEvent_Author(t *testing.T) {
+	// Событие вызвал сам автор
+	event, err := parseGitLabEvent(gitlabMergeRequestEvent, fixture(t, "gitlab_open.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "root", event.AuthorLogin)
+	assert.Zero(t, event.AuthorExternalID)
+
+	// MR открыт от имени другого пользователя
+	event, err = parseGitLabEvent(gitlabMergeRequestEvent, fixture(t, "gitlab_open_on_behalf.json"))
+	require.NoError(t, err)
+	assert.Empty(t, event.AuthorLogin)
+	assert.Equal(t, 42, event.AuthorExternalID)
+}
+
+func TestParseGitLabEvent_Ignored(t *testing.T) {
+	event, err := parseGitLabEvent("Push Hook", []byte(`{"object_kind":"push"}`))
+	require.NoError(t, err)
+	assert.Equal(t, models.VCS_ACTION_IGNORED, event.Action)
+}
+
+func TestParseGitLabEvent_Invalid(t *testing.T) {
+	_, err := parseGitLabEvent(gitlabMergeRequestEvent, []byte(`{"object_kind":"push"}`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
@@ -0,0 +1,401 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/requestctx"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/service/integrations")
+
+// Принимает события пул реквестов от GitHub и GitLab
+// и переносит их в сервис назначения ревьюверов
+type Integrations struct {
+	log *slog.Logger
+
+	// Секрет подписи вебхуков GitHub
+	githubSecret string
+	// Секретный токен вебхуков GitLab
+	gitlabToken string
+
+	assign        PRAssignment
+	loginProvider VCSLoginProvider
+	loginModifier VCSLoginModifier
+	// Пустой, если API GitLab недоступно
+	gitlabUsers GitLabUserProvider
+}
+
+// Интерфейсы для работы сервиса
+
+type PRAssignment interface {
+	CreatePullRequest(
+		ctx context.Context,
+		pullRequest models.PullRequest,
+	) (models.PullRequest, error)
+	MergeExternalPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	MarkReady(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	ClosePullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+	ReopenPullRequest(
+		ctx context.Context,
+		pullRequestID string,
+	) (models.PullRequest, error)
+}
+
+type VCSLoginProvider interface {
+	GetVCSLogins(
+		ctx context.Context,
+	) ([]models.VCSLogin, error)
+	GetUserIDByVCSLogin(
+		ctx context.Context,
+		provider models.VCSProvider,
+		login string,
+	) (string, error)
+}
+
+type GitLabUserProvider interface {
+	GetUsername(
+		ctx context.Context,
+		id int,
+	) (string, error)
+}
+
+type VCSLoginModifier interface {
+	SetVCSLogin(
+		ctx context.Context,
+		login models.VCSLogin,
+	) error
+	DeleteVCSLogin(
+		ctx context.Context,
+		provider models.VCSProvider,
+		login string,
+	) error
+}
+
+func New(
+	log *slog.Logger,
+	githubSecret string,
+	gitlabToken string,
+
+	assign PRAssignment,
+	loginProvider VCSLoginProvider,
+	loginModifier VCSLoginModifier,
+	gitlabUsers GitLabUserProvider,
+) *Integrations {
+	return &Integrations{
+		log:          log,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+
+		assign:        assign,
+		loginProvider: loginProvider,
+		loginModifier: loginModifier,
+		gitlabUsers:   gitlabUsers,
+	}
+}
+
+// Обрабатывает вебхук GitHub
+func (i *Integrations) HandleGitHubEvent(
+	ctx context.Context,
+	eventType string,
+	signature string,
+	body []byte,
+) (models.VCSAction, models.PullRequest, error) {
+	const op = "service.Integrations.HandleGitHubEvent"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+		slog.String("event_type", eventType),
+	)
+
+	// Проверяем подпись
+	if !verifyGitHubSignature(i.githubSecret, body, signature) {
+		log.Warn("Rejected GitHub webhook with invalid signature")
+		return "", models.PullRequest{}, ErrInvalidSignature
+	}
+
+	event, err := parseGitHubEvent(eventType, body)
+	if err != nil {
+		log.Error("Failed to parse GitHub webhook",
+			slog.String("err", err.Error()),
+		)
+		return "", models.PullRequest{}, err
+	}
+
+	return i.handleEvent(ctx, event)
+}
+
+// Обрабатывает вебхук GitLab
+func (i *Integrations) HandleGitLabEvent(
+	ctx context.Context,
+	eventType string,
+	token string,
+	body []byte,
+) (models.VCSAction, models.PullRequest, error) {
+	const op = "service.Integrations.HandleGitLabEvent"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+		slog.String("event_type", eventType),
+	)
+
+	// Проверяем токен
+	if !verifyGitLabToken(i.gitlabToken, token) {
+		log.Warn("Rejected GitLab webhook with invalid token")
+		return "", models.PullRequest{}, ErrInvalidSignature
+	}
+
+	event, err := parseGitLabEvent(eventType, body)
+	if err != nil {
+		log.Error("Failed to parse GitLab webhook",
+			slog.String("err", err.Error()),
+		)
+		return "", models.PullRequest{}, err
+	}
+
+	return i.handleEvent(ctx, event)
+}
+
+// Применяет событие пул реквеста. Ошибки сервиса назначения
+// возвращаются как есть, чтобы их можно было сопоставить с кодами API
+func (i *Integrations) handleEvent(
+	ctx context.Context,
+	event models.VCSPullRequestEvent,
+) (models.VCSAction, models.PullRequest, error) {
+	const op = "service.Integrations.handleEvent"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	// Изменения записываются в журнал от имени системы контроля версий
+	ctx = requestctx.WithActor(ctx, "integration:"+event.Provider)
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+		slog.String("provider", event.Provider),
+		slog.String("action", event.Action),
+		slog.String("pull_request_id", event.PullRequestID),
+	)
+
+	var (
+		pullRequest models.PullRequest
+		err         error
+	)
+
+	switch event.Action {
+	case models.VCS_ACTION_IGNORED:
+		log.Info("Ignored VCS event")
+		return event.Action, models.PullRequest{}, nil
+	case models.VCS_ACTION_OPENED:
+		pullRequest, err = i.createPullRequest(ctx, event)
+	case models.VCS_ACTION_READY:
+		pullRequest, err = i.assign.MarkReady(ctx, event.PullRequestID)
+	case models.VCS_ACTION_MERGED:
+		// Пул реквест уже замерджен во внешней системе, поэтому
+		// одобрения ревьюверов не требуются
+		pullRequest, err = i.assign.MergeExternalPullRequest(ctx, event.PullRequestID)
+	case models.VCS_ACTION_CLOSED:
+		pullRequest, err = i.assign.ClosePullRequest(ctx, event.PullRequestID)
+	case models.VCS_ACTION_REOPENED:
+		pullRequest, err = i.assign.ReopenPullRequest(ctx, event.PullRequestID)
+	}
+	if err != nil {
+		log.Error("Failed to apply VCS event",
+			slog.String("err", err.Error()),
+		)
+		return "", models.PullRequest{}, err
+	}
+
+	log.Info("Applied VCS event successfully")
+
+	return event.Action, pullRequest, nil
+}
+
+// Создаёт пул реквест, сопоставляя автора с пользователем сервиса
+func (i *Integrations) createPullRequest(
+	ctx context.Context,
+	event models.VCSPullRequestEvent,
+) (models.PullRequest, error) {
+	const op = "service.Integrations.createPullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	authorLogin, err := i.authorLogin(ctx, event)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	authorID, err := i.loginProvider.GetUserIDByVCSLogin(ctx, event.Provider, authorLogin)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.PullRequest{}, ErrUnknownLogin
+		}
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pullRequest := models.PullRequest{
+		ID:        event.PullRequestID,
+		Name:      event.Name,
+		AuthorID:  authorID,
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now().Truncate(time.Second),
+	}
+	if event.Draft {
+		pullRequest.Status = models.PULLREQUEST_DRAFT
+	}
+
+	return i.assign.CreatePullRequest(ctx, pullRequest)
+}
+
+// Определяет логин автора пул реквеста. Если в событии есть только
+// числовой ID автора, логин запрашивается через API GitLab
+func (i *Integrations) authorLogin(
+	ctx context.Context,
+	event models.VCSPullRequestEvent,
+) (string, error) {
+	const op = "service.Integrations.authorLogin"
+
+	if event.AuthorExternalID == 0 {
+		return event.AuthorLogin, nil
+	}
+
+	// Без API автора не определить, а тот, кто вызвал событие, автором не считается
+	if event.Provider != models.VCS_GITLAB || i.gitlabUsers == nil {
+		return "", ErrUnknownLogin
+	}
+
+	login, err := i.gitlabUsers.GetUsername(ctx, event.AuthorExternalID)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return login, nil
+}
+
+// Сопоставляет логин в системе контроля версий пользователю
+func (i *Integrations) SetLogin(
+	ctx context.Context,
+	login models.VCSLogin,
+) error {
+	const op = "service.Integrations.SetLogin"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+		slog.String("provider", login.Provider),
+		slog.String("login", login.Login),
+		slog.String("user_id", login.UserID),
+	)
+
+	log.Info("Attempting to set VCS login")
+
+	if login.Provider != models.VCS_GITHUB && login.Provider != models.VCS_GITLAB {
+		return ErrUnknownProvider
+	}
+
+	err := i.loginModifier.SetVCSLogin(ctx, login)
+	if err != nil {
+		log.Error("Failed to set VCS login",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Set VCS login successfully")
+
+	return nil
+}
+
+// Получает все соответствия логинов пользователям
+func (i *Integrations) ListLogins(
+	ctx context.Context,
+) ([]models.VCSLogin, error) {
+	const op = "service.Integrations.ListLogins"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+	)
+
+	log.Info("Attempting to list VCS logins")
+
+	logins, err := i.loginProvider.GetVCSLogins(ctx)
+	if err != nil {
+		log.Error("Failed to list VCS logins",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Listed VCS logins successfully")
+
+	return logins, nil
+}
+
+// Удаляет соответствие логина пользователю
+func (i *Integrations) DeleteLogin(
+	ctx context.Context,
+	provider models.VCSProvider,
+	login string,
+) error {
+	const op = "service.Integrations.DeleteLogin"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, i.log).With(
+		slog.String("op", op),
+		slog.String("provider", provider),
+		slog.String("login", login),
+	)
+
+	log.Info("Attempting to delete VCS login")
+
+	err := i.loginModifier.DeleteVCSLogin(ctx, provider, login)
+	if err != nil {
+		log.Error("Failed to delete VCS login",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Deleted VCS login successfully")
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package integrations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/memory"
+	"github.com/iskanye/avito-tech-internship/internal/service/prassignment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	githubSecret = "github-secret"
+	gitlabToken  = "gitlab-token"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Сервис назначения, запоминающий вызовы
+type assignment struct {
+	created []models.PullRequest
+	calls   []string
+}
+
+func (a *assignment) CreatePullRequest(
+	_ context.Context,
+	pullRequest models.PullRequest,
+) (models.PullRequest, error) {
+	a.created = append(a.created, pullRequest)
+	return pullRequest, nil
+}
+
+func (a *assignment) MergeExternalPullRequest(_ context.Context, id string) (models.PullRequest, error) {
+	a.calls = append(a.calls, "merge "+id)
+	return models.PullRequest{ID: id, Status: models.PULLREQUEST_MERGED}, nil
+}
+
+func (a *assignment) MarkReady(_ context.Context, id string) (models.PullRequest, error) {
+	a.calls = append(a.calls, "ready "+id)
+	return models.PullRequest{ID: id, Status: models.PULLREQUEST_OPEN}, nil
+}
+
+func (a *assignment) ClosePullRequest(_ context.Context, id string) (models.PullRequest, error) {
+	a.calls = append(a.calls, "close "+id)
+	return models.PullRequest{ID: id, Status: models.PULLREQUEST_CLOSED}, nil
+}
+
+func (a *assignment) ReopenPullRequest(_ context.Context, id string) (models.PullRequest, error) {
+	a.calls = append(a.calls, "reopen "+id)
+	return models.PullRequest{ID: id, Status: models.PULLREQUEST_OPEN}, nil
+}
+
+// Таблица соответствия логинов в памяти
+type logins map[string]string
+
+func (l logins) GetVCSLogins(context.Context) ([]models.VCSLogin, error) {
+	return nil, nil
+}
+
+func (l logins) GetUserIDByVCSLogin(
+	_ context.Context,
+	provider models.VCSProvider,
+	login string,
+) (string, error) {
+	userID, ok := l[provider+"/"+login]
+	if !ok {
+		return "", repositories.ErrNotFound
+	}
+	return userID, nil
+}
+
+func (l logins) SetVCSLogin(_ context.Context, login models.VCSLogin) error {
+	l[login.Provider+"/"+login.Login] = login.UserID
+	return nil
+}
+
+func (l logins) DeleteVCSLogin(_ context.Context, provider models.VCSProvider, login string) error {
+	delete(l, provider+"/"+login)
+	return nil
+}
+
+// Пользователи GitLab по числовым ID
+type gitlabUsers map[int]string
+
+func (u gitlabUsers) GetUsername(_ context.Context, id int) (string, error) {
+	username, ok := u[id]
+	if !ok {
+		return "", errors.New("gitlab user not found")
+	}
+	return username, nil
+}
+
+func newIntegrations(a *assignment, l logins) *Integrations {
+	return New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		githubSecret,
+		gitlabToken,
+		a,
+		l,
+		l,
+		gitlabUsers{1: "root", 42: "alice"},
+	)
+}
+
+func TestIntegrations_HandleGitHubEvent_Opened(t *testing.T) {
+	a := &assignment{}
+	i := newIntegrations(a, logins{"github/octocat": "u1"})
+
+	body := fixture(t, "github_opened_draft.json")
+	action, pullRequest, err := i.HandleGitHubEvent(
+		context.Background(), githubPullRequestEvent, sign(githubSecret, body), body,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.VCS_ACTION_OPENED, action)
+	require.Len(t, a.created, 1)
+	assert.Equal(t, "octo-org/backend#43", pullRequest.ID)
+	assert.Equal(t, "WIP: rework cache", pullRequest.Name)
+	assert.Equal(t, "u1", pullRequest.AuthorID)
+	assert.Equal(t, models.PULLREQUEST_DRAFT, pullRequest.Status)
+}
+
+func TestIntegrations_HandleGitHubEvent_Merged(t *testing.T) {
+	a := &assignment{}
+	i := newIntegrations(a, logins{})
+
+	body := fixture(t, "github_closed_merged.json")
+	action, pullRequest, err := i.HandleGitHubEvent(
+		context.Background(), githubPullRequestEvent, sign(githubSecret, body), body,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.VCS_ACTION_MERGED, action)
+	assert.Equal(t, models.PULLREQUEST_MERGED, pullRequest.Status)
+	assert.Equal(t, []string{"merge octo-org/backend#42"}, a.calls)
+}
+
+// Метрики сервиса назначения, которые никуда не пишутся
+type noMetrics struct{}
+
+func (noMetrics) ReviewerAssigned(string) {}
+func (noMetrics) ReviewerReassigned()     {}
+func (noMetrics) NoCandidates()           {}
+
+func TestIntegrations_HandleGitHubEvent_MergedWithoutApprovals(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	storage, err := memory.New(repositories.STRATEGY_LEAST_LOADED)
+	require.NoError(t, err)
+
+	// Для мерджа через сервис нужно одно одобрение
+	a := prassignment.New(
+		log,
+		1,
+		4,
+		memory.NewTxManager(storage),
+		storage, storage, storage, storage,
+		storage, storage, storage, storage,
+		storage, storage, storage,
+		storage, storage,
+		storage, storage, storage,
+		storage, storage,
+		storage,
+		noMetrics{},
+	)
+	i := New(log, githubSecret, gitlabToken, a, logins{}, logins{}, nil)
+
+	_, err = a.AddTeam(ctx, models.Team{
+		TeamName:       "backend",
+		ReviewersCount: 1,
+		Members: []models.User{
+			{UserID: "u1", Username: "u1", IsActive: true},
+			{UserID: "u2", Username: "u2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = a.CreatePullRequest(ctx, models.PullRequest{
+		ID:       "octo-org/backend#42",
+		Name:     "Add cache",
+		AuthorID: "u1",
+		Status:   models.PULLREQUEST_OPEN,
+	})
+	require.NoError(t, err)
+
+	_, err = a.MergePullRequest(ctx, "octo-org/backend#42")
+	require.ErrorIs(t, err, prassignment.ErrNotApproved)
+
+	// Пул реквест уже замерджен в GitHub, поэтому одобрения не нужны
+	body := fixture(t, "github_closed_merged.json")
+	action, pullRequest, err := i.HandleGitHubEvent(ctx, githubPullRequestEvent, sign(githubSecret, body), body)
+	require.NoError(t, err)
+	assert.Equal(t, models.VCS_ACTION_MERGED, action)
+	assert.Equal(t, models.PULLREQUEST_MERGED, pullRequest.Status)
+	assert.False(t, pullRequest.MergedAt.IsZero())
+}
+
+func TestIntegrations_HandleGitHubEvent_InvalidSignature(t *testing.T) {
+	a := &assignment{}
+	i := newIntegrations(a, logins{"github/octocat": "u1"})
+
+	body := fixture(t, "github_opened.json")
+	_, _, err := i.HandleGitHubEvent(
+		context.Background(), githubPullRequestEvent, sign("other", body), body,
+	)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	assert.Empty(t, a.created)
+}
+
+func TestIntegrations_HandleGitHubEvent_UnknownLogin(t *testing.T) {
+	a := &assignment{}
+	i := newIntegrations(a, logins{})
+
+	body := fixture(t, "github_opened.json")
+	_, _, err := i.HandleGitHubEvent(
+		context.Background(), githubPullRequestEvent, sign(githubSecret, body), body,
+	)
+	assert.ErrorIs(t, err, ErrUnknownLogin)
+	assert.Empty(t, a.created)
+}
+
+func TestIntegrations_HandleGitLabEvent(t *testing.T) {
+	a := &assignment{}
+	i := newIntegrations(a, logins{"gitlab/root": "u2"})
+
+	// MR открыт, затем готов к ревью и влит
+	for _, name := range []string{"gitlab_open.json", "gitlab_update_ready.json", "gitlab_merge.json"} {
+		_, _, err := i.HandleGitLabEvent(
+			context.Background(), gitlabMergeRequestEvent, gitlabToken, fixture(t, name),
+		)
+		require.NoError(t, err, name)
+	}
+
+	require.Len(t, a.created, 1)
+	assert.Equal(t, "u2", a.created[0].AuthorID)
+	assert.Equal(t, models.PULLREQUEST_OPEN, a.created[0].Status)
+	assert.Equal(t, []string{
+		"ready gitlabhq/gitlab-test!1",
+		"merge gitlabhq/gitlab-test!1",
+	}, a.calls)
+
+	_, _, err := i.HandleGitLabEvent(
+		context.Background(), gitlabMergeRequestEvent, "other", fixture(t, "gitlab_open.json"),
+	)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestIntegrations_HandleGitLabEvent_OnBehalf(t *testing.T) {
+	a := &assignment{}
+	l := logins{"gitlab/root": "u1", "gitlab/alice": "u2"}
+	i := newIntegrations(a, l)
+
+	// MR открыл root от имени alice, и автором считается alice
+	body := fixture(t, "gitlab_open_on_behalf.json")
+	_, pullRequest, err := i.HandleGitLabEvent(context.Background(), gitlabMergeRequestEvent, gitlabToken, body)
+	require.NoError(t, err)
+	assert.Equal(t, "gitlabhq/gitlab-test!2", pullRequest.ID)
+	assert.Equal(t, "u2", pullRequest.AuthorID)
+
+	// Без API GitLab автора не определить, и тот, кто открыл MR, автором не становится
+	i = New(slog.New(slog.NewTextHandler(io.Discard, nil)), githubSecret, gitlabToken, a, l, l, nil)
+	_, _, err = i.HandleGitLabEvent(context.Background(), gitlabMergeRequestEvent, gitlabToken, body)
+	assert.ErrorIs(t, err, ErrUnknownLogin)
+	assert.Len(t, a.created, 1)
+}
+
+func TestIntegrations_SetLogin_UnknownProvider(t *testing.T) {
+	i := newIntegrations(&assignment{}, logins{})
+
+	err := i.SetLogin(context.Background(), models.VCSLogin{Provider: "bitbucket", Login: "x", UserID: "u1"})
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
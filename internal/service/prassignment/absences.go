@@ -0,0 +1,255 @@
+package prassignment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+)
+
+// Добавляет период отсутствия пользователя
+func (a *PRAssignment) AddAbsence(
+	ctx context.Context,
+	absence models.Absence,
+) (models.Absence, error) {
+	const op = "service.PRAssignment.AddAbsence"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("user_id", absence.UserID),
+	)
+
+	log.Info("Attempting to add absence")
+
+	// Период должен заканчиваться после начала
+	if !absence.EndsAt.After(absence.StartsAt) {
+		log.Error("Invalid absence period")
+
+		return models.Absence{}, ErrInvalidAbsence
+	}
+
+	// Начинаем транзакцию
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Добавляем период отсутствия
+		id, err := a.absCreator.AddAbsence(ctx, absence)
+		if err != nil {
+			log.Error("Failed to add absence",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		absence.ID = id
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_ABSENCE_ADD,
+			UserID:    absence.UserID,
+		}, nil, absenceSnapshot(&absence))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.Absence{}, err
+	}
+
+	log.Info("Added absence successfully")
+
+	return absence, nil
+}
+
+// Получает периоды отсутствия пользователя
+func (a *PRAssignment) GetAbsences(
+	ctx context.Context,
+	userID string,
+) ([]models.Absence, error) {
+	const op = "service.PRAssignment.GetAbsences"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("Attempting to get absences")
+
+	// Получаем периоды отсутствия
+	absences, err := a.absProvider.GetAbsences(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get absences",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got absences successfully")
+
+	return absences, nil
+}
+
+// Удаляет период отсутствия пользователя
+func (a *PRAssignment) DeleteAbsence(
+	ctx context.Context,
+	userID string,
+	absenceID int64,
+) error {
+	const op = "service.PRAssignment.DeleteAbsence"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.Int64("absence_id", absenceID),
+	)
+
+	log.Info("Attempting to delete absence")
+
+	// Начинаем транзакцию
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Находим удаляемый период для журнала
+		absences, err := a.absProvider.GetAbsences(ctx, userID)
+		if err != nil {
+			log.Error("Failed to get absences",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		idx := slices.IndexFunc(absences, func(absence models.Absence) bool {
+			return absence.ID == absenceID
+		})
+		if idx < 0 {
+			log.Error("Absence not found")
+
+			return ErrNotFound
+		}
+
+		// Удаляем период отсутствия
+		err = a.absModifier.DeleteAbsence(ctx, userID, absenceID)
+		if err != nil {
+			log.Error("Failed to delete absence",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_ABSENCE_DELETE,
+			UserID:    userID,
+		}, absenceSnapshot(&absences[idx]), nil)
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleted absence successfully")
+
+	return nil
+}
+
+// Переназначает открытые ревью пользователей, чьё отсутствие уже началось.
+// Каждый период отсутствия обрабатывается один раз
+func (a *PRAssignment) ReassignAbsentUsers(
+	ctx context.Context,
+) ([]models.Reassignment, error) {
+	const op = "service.PRAssignment.ReassignAbsentUsers"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+	)
+
+	log.Info("Attempting to reassign absent users")
+
+	// Получаем начавшиеся периоды отсутствия
+	absences, err := a.absProvider.GetStartedAbsences(ctx, time.Now())
+	if err != nil {
+		log.Error("Failed to get started absences",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reassignments := make([]models.Reassignment, 0)
+	for _, absence := range absences {
+		userReassignments, err := a.reassignReviews(ctx, []string{absence.UserID}, reassignReasons{
+			replace: models.ASSIGNMENT_ABSENCE,
+			topUp:   models.ASSIGNMENT_ABSENCE,
+		}, models.AuditEvent{
+			Operation: models.AUDIT_ABSENCE_REASSIGN,
+		})
+		if err != nil {
+			log.Error("Failed to reassign absent user",
+				slog.String("user_id", absence.UserID),
+				slog.String("err", err.Error()),
+			)
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Помечаем период отсутствия обработанным
+		err = a.absModifier.MarkAbsenceReassigned(ctx, absence.ID)
+		if err != nil {
+			log.Error("Failed to mark absence reassigned",
+				slog.String("err", err.Error()),
+			)
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reassignments = append(reassignments, userReassignments...)
+	}
+
+	log.Info("Reassigned absent users successfully",
+		slog.Int("reassignments", len(reassignments)),
+	)
+
+	return reassignments, nil
+}
@@ -0,0 +1,180 @@
+package prassignment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/requestctx"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+)
+
+// Записывает изменение в журнал. Должен вызываться внутри транзакции
+// изменения, тогда запись сохранится только вместе с ним.
+// Инициатор и ID запроса берутся из контекста
+func (a *PRAssignment) audit(
+	ctx context.Context,
+	event models.AuditEvent,
+	before any,
+	after any,
+) error {
+	var err error
+
+	if before != nil {
+		event.Before, err = json.Marshal(before)
+		if err != nil {
+			return err
+		}
+	}
+	if after != nil {
+		event.After, err = json.Marshal(after)
+		if err != nil {
+			return err
+		}
+	}
+
+	event.Actor = requestctx.Actor(ctx)
+	event.RequestID = requestctx.RequestID(ctx)
+	event.OccurredAt = time.Now()
+
+	return a.auditCreator.AddAuditEvent(ctx, event)
+}
+
+// Получает страницу журнала изменений и курсор следующей страницы
+func (a *PRAssignment) ListAuditEvents(
+	ctx context.Context,
+	filter models.AuditFilter,
+	cursor string,
+) ([]models.AuditEvent, string, error) {
+	const op = "service.PRAssignment.ListAuditEvents"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.Int("limit", filter.Limit),
+	)
+
+	log.Info("Attempting to list audit events")
+
+	// Проверяем параметры
+	if filter.Limit < 1 || filter.Limit > maxPageSize {
+		log.Error("Invalid page size")
+
+		return nil, "", ErrInvalidLimit
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		log.Error("Invalid time range")
+
+		return nil, "", ErrInvalidFilter
+	}
+
+	// Декодируем позицию предыдущей страницы
+	if cursor != "" {
+		var after models.AuditCursor
+		err := decodeCursor(cursor, &after)
+		if err != nil {
+			log.Error("Failed to decode cursor")
+
+			return nil, "", err
+		}
+		filter.After = &after
+	}
+
+	// Берём на один элемент больше, чтобы узнать есть ли следующая страница
+	pageSize := filter.Limit
+	filter.Limit++
+
+	events, err := a.auditProvider.ListAuditEvents(ctx, filter)
+	if err != nil {
+		log.Error("Failed to list audit events",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Последняя страница
+	if len(events) <= pageSize {
+		log.Info("Listed audit events successfully")
+
+		return events, "", nil
+	}
+
+	// Курсор указывает на последнюю запись страницы
+	events = events[:pageSize]
+	nextCursor, err := encodeCursor(models.AuditCursor{ID: events[pageSize-1].ID})
+	if err != nil {
+		log.Error("Failed to encode cursor",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Listed audit events successfully")
+
+	return events, nextCursor, nil
+}
+
+func pullRequestSnapshot(pullRequest *models.PullRequest) models.AuditPullRequest {
+	snapshot := models.AuditPullRequest{
+		PullRequestID:   pullRequest.ID,
+		PullRequestName: pullRequest.Name,
+		AuthorID:        pullRequest.AuthorID,
+		Status:          pullRequest.Status,
+		// Копия, отсортированная для сравнения снимков
+		AssignedReviewers: slices.Sorted(slices.Values(pullRequest.AssignedReviewers)),
+	}
+	if snapshot.AssignedReviewers == nil {
+		snapshot.AssignedReviewers = []string{}
+	}
+	if len(pullRequest.Reviews) > 0 {
+		snapshot.Reviews = make(map[string]models.ReviewVerdict, len(pullRequest.Reviews))
+		for _, review := range pullRequest.Reviews {
+			snapshot.Reviews[review.ReviewerID] = review.Verdict
+		}
+	}
+
+	return snapshot
+}
+
+func teamSnapshot(team *models.Team) models.AuditTeam {
+	snapshot := models.AuditTeam{
+		TeamName:       team.TeamName,
+		ReviewersCount: team.ReviewersCount,
+		Members:        make([]models.AuditUser, len(team.Members)),
+	}
+	for i, member := range team.Members {
+		snapshot.Members[i] = userSnapshot(&member)
+		// Команда участников совпадает с командой снимка
+		snapshot.Members[i].TeamName = ""
+	}
+
+	return snapshot
+}
+
+func userSnapshot(user *models.User) models.AuditUser {
+	return models.AuditUser{
+		UserID:         user.UserID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+}
+
+func absenceSnapshot(absence *models.Absence) models.AuditAbsence {
+	return models.AuditAbsence{
+		AbsenceID: absence.ID,
+		UserID:    absence.UserID,
+		StartsAt:  absence.StartsAt,
+		EndsAt:    absence.EndsAt,
+		Reason:    absence.Reason,
+	}
+}
@@ -0,0 +1,31 @@
+package prassignment
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Кодирует позицию в списке в непрозрачный для клиента курсор
+func encodeCursor(position any) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Декодирует курсор, полученный от клиента
+func decodeCursor(cursor string, position any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	err = json.Unmarshal(data, position)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package prassignment
+
+import (
+	"context"
+	"errors"
+)
+
+// Ошибка, которой откатывается транзакция пробного запуска
+var errDryRun = errors.New("dry run")
+
+// Метрики назначений, которые никуда не пишутся
+type noMetrics struct{}
+
+func (noMetrics) ReviewerAssigned(string) {}
+func (noMetrics) ReviewerReassigned()     {}
+func (noMetrics) NoCandidates()           {}
+
+// Выполняет f в транзакции, которая всегда откатывается, вместе с
+// событиями и журналом изменений. Вложенные транзакции f выполняются
+// в ней же, поэтому пул реквесты переназначаются по очереди, а метрики
+// не записываются
+func (a *PRAssignment) dryRun(
+	ctx context.Context,
+	f func(ctx context.Context, preview *PRAssignment) error,
+) error {
+	preview := *a
+	preview.reassignParallelism = 1
+	preview.metrics = noMetrics{}
+
+	err := a.txManager.Do(ctx, func(ctx context.Context) error {
+		err := f(ctx, &preview)
+		if err != nil {
+			return err
+		}
+
+		return errDryRun
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+
+	return err
+}
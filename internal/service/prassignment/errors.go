@@ -6,7 +6,20 @@ var (
 	ErrNotFound     = errors.New("resource not found")
 	ErrTeamExists   = errors.New("team_name already exists")
 	ErrPRExists     = errors.New("PR id already exists")
-	ErrPRMerged     = errors.New("cannot reassign on merged PR")
+	ErrPRMerged     = errors.New("PR is already merged")
 	ErrNotAssigned  = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidates = errors.New("no active replacement candidate in team")
+
+	ErrInvalidReviewersCount = errors.New("reviewers_count must be positive")
+	ErrInvalidVerdict        = errors.New("unknown review verdict")
+	ErrNotApproved           = errors.New("PR is not approved")
+	ErrPRClosed              = errors.New("PR is closed")
+	ErrPRDraft               = errors.New("PR is a draft")
+	ErrInvalidCursor         = errors.New("invalid cursor")
+	ErrInvalidLimit          = errors.New("limit must be between 1 and 100")
+	ErrInvalidFilter         = errors.New("invalid filter")
+	ErrInvalidAbsence        = errors.New("absence must end after it starts")
+	ErrInvalidMaxOpenReviews = errors.New("max_open_reviews must not be negative")
+	ErrInvalidPullRequest    = errors.New("pull_request_id and author_id must not be empty")
+	ErrInvalidReviewers      = errors.New("reviewers must be unique and must not include the author")
 )
@@ -0,0 +1,90 @@
+package prassignment
+
+import (
+	"context"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+)
+
+// Публикует событие. Должен вызываться внутри транзакции изменения,
+// тогда событие отправится только если изменение сохранилось
+func (a *PRAssignment) publish(
+	ctx context.Context,
+	eventType models.EventType,
+	data any,
+) error {
+	return a.publisher.PublishEvent(ctx, models.Event{
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Data:       data,
+	})
+}
+
+// Доназначает ревьюверов и публикует события о назначении
+func (a *PRAssignment) assignReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	authorID string,
+	reason models.AssignmentReason,
+) error {
+	reviewers, err := a.revAssigner.AssignReviewers(ctx, pullRequestID, authorID, reason)
+	if err != nil {
+		return err
+	}
+
+	return a.publishAssigned(ctx, pullRequestID, reviewers)
+}
+
+// Публикует события о назначении ревьюверов
+func (a *PRAssignment) publishAssigned(
+	ctx context.Context,
+	pullRequestID string,
+	reviewers []string,
+) error {
+	for _, reviewerID := range reviewers {
+		err := a.publish(ctx, models.EVENT_REVIEWER_ASSIGNED, models.ReviewerEventData{
+			PullRequestID: pullRequestID,
+			ReviewerID:    reviewerID,
+		})
+		if err != nil {
+			return err
+		}
+
+		a.metricsFor(ctx).ReviewerAssigned(reviewerID)
+	}
+
+	return nil
+}
+
+// Публикует событие о замене ревьювера
+func (a *PRAssignment) publishReassigned(
+	ctx context.Context,
+	pullRequestID string,
+	oldReviewerID string,
+	newReviewerID string,
+) error {
+	err := a.publish(ctx, models.EVENT_REVIEWER_REASSIGNED, models.ReviewerEventData{
+		PullRequestID: pullRequestID,
+		ReviewerID:    newReviewerID,
+		OldReviewerID: oldReviewerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.metricsFor(ctx).ReviewerReassigned()
+	a.metricsFor(ctx).ReviewerAssigned(newReviewerID)
+
+	return nil
+}
+
+func pullRequestEventData(pullRequest *models.PullRequest) models.PullRequestEventData {
+	return models.PullRequestEventData{
+		PullRequestID:     pullRequest.ID,
+		PullRequestName:   pullRequest.Name,
+		AuthorID:          pullRequest.AuthorID,
+		Status:            pullRequest.Status,
+		AssignedReviewers: pullRequest.AssignedReviewers,
+	}
+}
@@ -0,0 +1,67 @@
+package prassignment
+
+import (
+	"context"
+	"sync"
+)
+
+type txMetricsKey struct{}
+
+// Метрики, накопленные в транзакции. Записываются только после её
+// коммита, поэтому откатившиеся назначения не попадают в метрики
+type txMetrics struct {
+	mu    sync.Mutex
+	calls []func(m Metrics)
+}
+
+func (t *txMetrics) record(call func(m Metrics)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.calls = append(t.calls, call)
+}
+
+func (t *txMetrics) ReviewerAssigned(reviewerID string) {
+	t.record(func(m Metrics) { m.ReviewerAssigned(reviewerID) })
+}
+
+func (t *txMetrics) ReviewerReassigned() {
+	t.record(func(m Metrics) { m.ReviewerReassigned() })
+}
+
+func (t *txMetrics) NoCandidates() {
+	t.record(func(m Metrics) { m.NoCandidates() })
+}
+
+// Выполняет f в транзакции и записывает накопленные в ней метрики,
+// если транзакция закоммитилась. Вложенная транзакция выполняется
+// во внешней, и её метрики записываются вместе с метриками внешней
+func (a *PRAssignment) inTx(
+	ctx context.Context,
+	f func(ctx context.Context) error,
+) error {
+	if _, ok := ctx.Value(txMetricsKey{}).(*txMetrics); ok {
+		return a.txManager.Do(ctx, f)
+	}
+
+	pending := &txMetrics{}
+	err := a.txManager.Do(context.WithValue(ctx, txMetricsKey{}, pending), f)
+	if err != nil {
+		return err
+	}
+
+	for _, call := range pending.calls {
+		call(a.metrics)
+	}
+
+	return nil
+}
+
+// Метрики текущей транзакции. Вне транзакции метрики записываются сразу
+func (a *PRAssignment) metricsFor(ctx context.Context) Metrics {
+	if pending, ok := ctx.Value(txMetricsKey{}).(*txMetrics); ok {
+		return pending
+	}
+
+	return a.metrics
+}
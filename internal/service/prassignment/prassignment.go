@@ -6,18 +6,28 @@ import (
 	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/service/prassignment")
+
 type PRAssignment struct {
 	log *slog.Logger
 
+	// Количество одобрений, необходимое для мерджа (0 - не требуется)
+	requiredApprovals int
+
+	// Сколько пул реквестов переназначается параллельно
+	reassignParallelism int
+
 	// Менеджер транзакций
 	txManager TransactionManager
 
 	// Объекты для взаимодействия с пользователями
-	userCreator  UserCreator
-	userModifier UserModifier
-	userProvider UserProvider
+	userCreator    UserCreator
+	userModifier   UserModifier
+	userProvider   UserProvider
+	userStatistics UserStatistics
 
 	// Объекты для взаимодействия с командами
 	teamCreator    TeamCreator
@@ -33,6 +43,21 @@ type PRAssignment struct {
 	// Объекты для взаимодействия с ревьюверами
 	revAssigner ReviewersAssigner
 	revModifier ReviewersModifier
+
+	// Объекты для взаимодействия с периодами отсутствия
+	absCreator  AbsenceCreator
+	absProvider AbsenceProvider
+	absModifier AbsenceModifier
+
+	// Журнал изменений
+	auditCreator  AuditCreator
+	auditProvider AuditProvider
+
+	// Исходящая очередь событий
+	publisher EventPublisher
+
+	// Метрики назначений
+	metrics Metrics
 }
 
 // Менеджер транзакций
@@ -65,12 +90,31 @@ type UserModifier interface {
 		userID string,
 		isActive bool,
 	) error
+	SetMaxOpenReviews(
+		ctx context.Context,
+		userID string,
+		maxOpenReviews *int,
+	) error
+}
+
+type UserStatistics interface {
+	GetUserStats(
+		ctx context.Context,
+		userID string,
+		from time.Time,
+	) (models.UserStats, error)
+	GetTeamUserStats(
+		ctx context.Context,
+		teamName string,
+		from time.Time,
+	) ([]models.UserStats, error)
 }
 
 type TeamCreator interface {
 	AddTeam(
 		ctx context.Context,
 		teamName string,
+		reviewersCount int,
 	) (int64, error)
 }
 
@@ -86,18 +130,43 @@ type TeamModifier interface {
 		ctx context.Context,
 		teamName string,
 	) error
+	UpdateTeam(
+		ctx context.Context,
+		teamName string,
+		reviewersCount int,
+	) error
 }
 
 type TeamStatistics interface {
 	GetTeamsPullRequests(
 		ctx context.Context,
 		teamName string,
-	) (
-		pullRequests int,
-		openPullRequests int,
-		mergedPullRequests int,
-		err error,
-	)
+	) (map[models.PRStatus]int, error)
+	GetMergeTimes(
+		ctx context.Context,
+		teamName string,
+		from time.Time,
+		to time.Time,
+	) (models.MergeTimes, error)
+	GetOpenPullRequestAges(
+		ctx context.Context,
+		teamName string,
+		from time.Time,
+		to time.Time,
+		now time.Time,
+		bounds []time.Duration,
+	) ([]int, error)
+	GetOldestOpenPullRequests(
+		ctx context.Context,
+		teamName string,
+		from time.Time,
+		to time.Time,
+		limit int,
+	) ([]models.PullRequest, error)
+	RollupMergeTimes(
+		ctx context.Context,
+		until time.Time,
+	) error
 }
 
 type PRCreator interface {
@@ -116,6 +185,14 @@ type PRProvider interface {
 		ctx context.Context,
 		userID string,
 	) ([]models.PullRequest, error)
+	ListPullRequests(
+		ctx context.Context,
+		filter models.PullRequestFilter,
+	) ([]models.PullRequest, error)
+	GetReviewerHistory(
+		ctx context.Context,
+		pullRequestID string,
+	) ([]models.ReviewerAssignment, error)
 }
 
 type PRModifier interface {
@@ -124,6 +201,11 @@ type PRModifier interface {
 		pullRequestID string,
 		mergedAt time.Time,
 	) error
+	SetStatus(
+		ctx context.Context,
+		pullRequestID string,
+		status models.PRStatus,
+	) error
 }
 
 type ReviewersAssigner interface {
@@ -131,6 +213,13 @@ type ReviewersAssigner interface {
 		ctx context.Context,
 		pullRequestID string,
 		authorID string,
+		reason models.AssignmentReason,
+	) ([]string, error)
+	AddReviewers(
+		ctx context.Context,
+		pullRequestID string,
+		reviewerIDs []string,
+		reason models.AssignmentReason,
 	) error
 }
 
@@ -139,16 +228,103 @@ type ReviewersModifier interface {
 		ctx context.Context,
 		pullRequestID string,
 		oldReviewerID string,
+		reason models.AssignmentReason,
+		skipLocked bool,
 	) (string, error)
+	SetVerdict(
+		ctx context.Context,
+		pullRequestID string,
+		reviewerID string,
+		verdict models.ReviewVerdict,
+	) error
+}
+
+type AbsenceCreator interface {
+	AddAbsence(
+		ctx context.Context,
+		absence models.Absence,
+	) (int64, error)
+}
+
+type AbsenceProvider interface {
+	GetAbsences(
+		ctx context.Context,
+		userID string,
+	) ([]models.Absence, error)
+	GetStartedAbsences(
+		ctx context.Context,
+		now time.Time,
+	) ([]models.Absence, error)
+}
+
+type AbsenceModifier interface {
+	DeleteAbsence(
+		ctx context.Context,
+		userID string,
+		absenceID int64,
+	) error
+	MarkAbsenceReassigned(
+		ctx context.Context,
+		absenceID int64,
+	) error
+}
+
+type AuditCreator interface {
+	AddAuditEvent(
+		ctx context.Context,
+		event models.AuditEvent,
+	) error
+}
+
+type AuditProvider interface {
+	ListAuditEvents(
+		ctx context.Context,
+		filter models.AuditFilter,
+	) ([]models.AuditEvent, error)
+}
+
+type EventPublisher interface {
+	PublishEvent(
+		ctx context.Context,
+		event models.Event,
+	) error
+}
+
+// Метрики назначений. Изменения в транзакции попадают в метрики
+// только после её коммита
+type Metrics interface {
+	ReviewerAssigned(reviewerID string)
+	ReviewerReassigned()
+	NoCandidates()
+}
+
+// Публикует событие каждому получателю по очереди
+type EventPublishers []EventPublisher
+
+func (p EventPublishers) PublishEvent(
+	ctx context.Context,
+	event models.Event,
+) error {
+	for _, publisher := range p {
+		err := publisher.PublishEvent(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func New(
 	log *slog.Logger,
+	requiredApprovals int,
+	reassignParallelism int,
 	txManager TransactionManager,
 
 	userCreator UserCreator,
 	userModifier UserModifier,
 	userProvider UserProvider,
+	userStatistics UserStatistics,
 
 	teamCreator TeamCreator,
 	teamProvider TeamProvider,
@@ -161,14 +337,27 @@ func New(
 
 	revAssigner ReviewersAssigner,
 	revModifier ReviewersModifier,
+
+	absCreator AbsenceCreator,
+	absProvider AbsenceProvider,
+	absModifier AbsenceModifier,
+
+	auditCreator AuditCreator,
+	auditProvider AuditProvider,
+
+	publisher EventPublisher,
+	metrics Metrics,
 ) *PRAssignment {
 	return &PRAssignment{
-		log:       log,
-		txManager: txManager,
+		log:                 log,
+		requiredApprovals:   requiredApprovals,
+		reassignParallelism: max(reassignParallelism, 1),
+		txManager:           txManager,
 
-		userCreator:  userCreator,
-		userModifier: userModifier,
-		userProvider: userProvider,
+		userCreator:    userCreator,
+		userModifier:   userModifier,
+		userProvider:   userProvider,
+		userStatistics: userStatistics,
 
 		teamCreator:    teamCreator,
 		teamProvider:   teamProvider,
@@ -181,5 +370,15 @@ func New(
 
 		revAssigner: revAssigner,
 		revModifier: revModifier,
+
+		absCreator:  absCreator,
+		absProvider: absProvider,
+		absModifier: absModifier,
+
+		auditCreator:  auditCreator,
+		auditProvider: auditProvider,
+
+		publisher: publisher,
+		metrics:   metrics,
 	}
 }
@@ -0,0 +1,628 @@
+package prassignment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Публикует события в хранилище, пока не задана ошибка. Если задан
+// pullRequestID, ошибка возвращается только для событий этого PR
+type failingPublisher struct {
+	storage       *memory.Storage
+	err           error
+	pullRequestID string
+}
+
+func (p *failingPublisher) PublishEvent(
+	ctx context.Context,
+	event models.Event,
+) error {
+	data, ok := event.Data.(models.PullRequestEventData)
+	if p.err != nil && (p.pullRequestID == "" || ok && data.PullRequestID == p.pullRequestID) {
+		return p.err
+	}
+	return p.storage.PublishEvent(ctx, event)
+}
+
+// Метрики, считающие назначения ревьюверов
+type countingMetrics struct {
+	assigned   map[string]int
+	reassigned int
+}
+
+func (m *countingMetrics) ReviewerAssigned(reviewerID string) { m.assigned[reviewerID]++ }
+func (m *countingMetrics) ReviewerReassigned()                { m.reassigned++ }
+func (m *countingMetrics) NoCandidates()                      {}
+
+// Создаёт сервис поверх хранилища в памяти
+func newAssignment(t *testing.T) (*PRAssignment, *memory.Storage, *failingPublisher) {
+	t.Helper()
+
+	storage, err := memory.New(repositories.STRATEGY_LEAST_LOADED)
+	require.NoError(t, err)
+
+	publisher := &failingPublisher{storage: storage}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	a := New(
+		log,
+		0,
+		4,
+		memory.NewTxManager(storage),
+		storage, storage, storage, storage,
+		storage, storage, storage, storage,
+		storage, storage, storage,
+		storage, storage,
+		storage, storage, storage,
+		storage, storage,
+		publisher,
+		noMetrics{},
+	)
+
+	return a, storage, publisher
+}
+
+// Добавляет команду из активных пользователей с данными ID
+func addTeam(t *testing.T, a *PRAssignment, teamName string, reviewersCount int, userIDs ...string) {
+	t.Helper()
+
+	team := models.Team{
+		TeamName:       teamName,
+		ReviewersCount: reviewersCount,
+	}
+	for _, userID := range userIDs {
+		team.Members = append(team.Members, models.User{
+			UserID:   userID,
+			Username: userID,
+			IsActive: true,
+		})
+	}
+
+	_, err := a.AddTeam(context.Background(), team)
+	require.NoError(t, err)
+}
+
+func createPR(t *testing.T, a *PRAssignment, pullRequestID string, authorID string) models.PullRequest {
+	t.Helper()
+
+	pullRequest, err := a.CreatePullRequest(context.Background(), models.PullRequest{
+		ID:        pullRequestID,
+		Name:      pullRequestID,
+		AuthorID:  authorID,
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	return pullRequest
+}
+
+func TestAddTeam_Exists(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 2, "u1", "u2")
+
+	_, err := a.AddTeam(ctx, models.Team{
+		TeamName:       "backend",
+		ReviewersCount: 1,
+		Members:        []models.User{{UserID: "u3", Username: "u3", IsActive: true}},
+	})
+	require.ErrorIs(t, err, ErrTeamExists)
+
+	// Команда и её пользователи не изменились
+	team, err := a.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, 2, team.ReviewersCount)
+	assert.Len(t, team.Members, 2)
+
+	_, err = a.SetIsActive(ctx, "u3", false)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreatePullRequest_AssignsReviewers(t *testing.T) {
+	a, _, _ := newAssignment(t)
+
+	addTeam(t, a, "backend", 2, "u1", "u2", "u3")
+
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	assert.ElementsMatch(t, []string{"u2", "u3"}, pullRequest.AssignedReviewers)
+	assert.Zero(t, pullRequest.ReviewersMissing)
+
+	history, err := a.GetPullRequestHistory(context.Background(), "pr-1")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	for _, assignment := range history {
+		assert.Equal(t, models.ASSIGNMENT_INITIAL, assignment.Reason)
+		assert.True(t, assignment.UnassignedAt.IsZero())
+	}
+}
+
+func TestCreatePullRequest_Exists(t *testing.T) {
+	a, _, _ := newAssignment(t)
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	_, err := a.CreatePullRequest(context.Background(), models.PullRequest{
+		ID:       "pr-1",
+		AuthorID: "u2",
+		Status:   models.PULLREQUEST_OPEN,
+	})
+	require.ErrorIs(t, err, ErrPRExists)
+}
+
+func TestCreatePullRequest_Rollback(t *testing.T) {
+	a, _, publisher := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+
+	// Ошибка публикации откатывает создание PR вместе с назначениями
+	publisher.err = errors.New("queue is unavailable")
+	_, err := a.CreatePullRequest(ctx, models.PullRequest{
+		ID:        "pr-1",
+		Name:      "pr-1",
+		AuthorID:  "u1",
+		Status:    models.PULLREQUEST_OPEN,
+		CreatedAt: time.Now(),
+	})
+	require.ErrorIs(t, err, publisher.err)
+
+	_, err = a.GetPullRequest(ctx, "pr-1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	review, err := a.GetReview(ctx, "u2")
+	require.NoError(t, err)
+	assert.Empty(t, review)
+
+	events, _, err := a.ListAuditEvents(ctx, models.AuditFilter{
+		PullRequestID: "pr-1",
+		Limit:         10,
+	}, "")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// После восстановления очереди PR создаётся заново
+	publisher.err = nil
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	assert.Equal(t, []string{"u2"}, pullRequest.AssignedReviewers)
+}
+
+func TestCreatePullRequests_Results(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	// Неудачные PR откатывают пачку, а остальные создаются по одному
+	results, err := a.CreatePullRequests(ctx, []models.PullRequest{
+		{ID: "pr-1", Name: "pr-1", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-2", Name: "pr-2", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-3", Name: "pr-3", AuthorID: "u404", Status: models.PULLREQUEST_OPEN},
+		{ID: "", Name: "pr-4", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-2", Name: "pr-2", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+	}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	assert.ErrorIs(t, results[0].Err, ErrPRExists)
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, []string{"u2"}, results[1].PullRequest.AssignedReviewers)
+	assert.ErrorIs(t, results[2].Err, ErrNotFound)
+	assert.ErrorIs(t, results[3].Err, ErrInvalidPullRequest)
+	assert.ErrorIs(t, results[4].Err, ErrPRExists)
+
+	_, err = a.GetPullRequest(ctx, "pr-2")
+	require.NoError(t, err)
+	_, err = a.GetPullRequest(ctx, "pr-3")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreatePullRequests_KeepsCommitted(t *testing.T) {
+	a, _, publisher := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	// Внутренняя ошибка при создании по одному остаётся итогом своего PR,
+	// а уже созданные PR не теряются
+	publisher.err = errors.New("queue is unavailable")
+	publisher.pullRequestID = "pr-3"
+	results, err := a.CreatePullRequests(ctx, []models.PullRequest{
+		{ID: "pr-1", Name: "pr-1", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-2", Name: "pr-2", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-3", Name: "pr-3", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-4", Name: "pr-4", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+	}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	assert.ErrorIs(t, results[0].Err, ErrPRExists)
+	require.NoError(t, results[1].Err)
+	assert.ErrorIs(t, results[2].Err, publisher.err)
+	require.NoError(t, results[3].Err)
+
+	_, err = a.GetPullRequest(ctx, "pr-2")
+	require.NoError(t, err)
+	_, err = a.GetPullRequest(ctx, "pr-3")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = a.GetPullRequest(ctx, "pr-4")
+	require.NoError(t, err)
+
+	// Если не создан ни один PR, возвращается ошибка
+	publisher.pullRequestID = ""
+	_, err = a.CreatePullRequests(ctx, []models.PullRequest{
+		{ID: "pr-5", Name: "pr-5", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+	}, false)
+	require.ErrorIs(t, err, publisher.err)
+}
+
+func TestCreatePullRequests_MetricsAfterCommit(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+	metrics := &countingMetrics{assigned: map[string]int{}}
+	a.metrics = metrics
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+
+	// Назначение из откатившейся пачки не считается, а при создании
+	// по одному считается один раз
+	results, err := a.CreatePullRequests(ctx, []models.PullRequest{
+		{ID: "pr-1", Name: "pr-1", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-2", Name: "pr-2", AuthorID: "u404", Status: models.PULLREQUEST_OPEN},
+	}, false)
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, ErrNotFound)
+
+	assert.Equal(t, map[string]int{"u2": 1}, metrics.assigned)
+}
+
+func TestCreatePullRequests_KeepReviewers(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	addTeam(t, a, "frontend", 1, "u3")
+
+	// Переданные ревьюверы назначаются как есть, даже из другой команды
+	results, err := a.CreatePullRequests(ctx, []models.PullRequest{
+		{ID: "pr-1", Name: "pr-1", AuthorID: "u1", Status: models.PULLREQUEST_OPEN, AssignedReviewers: []string{"u2", "u3"}},
+		{ID: "pr-2", Name: "pr-2", AuthorID: "u1", Status: models.PULLREQUEST_OPEN},
+		{ID: "pr-3", Name: "pr-3", AuthorID: "u1", Status: models.PULLREQUEST_OPEN, AssignedReviewers: []string{"u1"}},
+		{ID: "pr-4", Name: "pr-4", AuthorID: "u1", Status: models.PULLREQUEST_OPEN, AssignedReviewers: []string{"u404"}},
+	}, true)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []string{"u2", "u3"}, results[0].PullRequest.AssignedReviewers)
+	// Без переданных ревьюверов они назначаются как обычно
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, []string{"u2"}, results[1].PullRequest.AssignedReviewers)
+	assert.Zero(t, results[1].PullRequest.ReviewersMissing)
+	assert.ErrorIs(t, results[2].Err, ErrInvalidReviewers)
+	assert.ErrorIs(t, results[3].Err, ErrNotFound)
+
+	history, err := a.GetPullRequestHistory(ctx, "pr-1")
+	require.NoError(t, err)
+	for _, assignment := range history {
+		assert.Equal(t, models.ASSIGNMENT_IMPORT, assignment.Reason)
+	}
+}
+
+func TestMergePullRequest_Idempotent(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	merged, err := a.MergePullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PULLREQUEST_MERGED, merged.Status)
+
+	again, err := a.MergePullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, merged.MergedAt, again.MergedAt)
+
+	_, _, err = a.ReassignPullRequest(ctx, "pr-1", "u2")
+	require.ErrorIs(t, err, ErrPRMerged)
+}
+
+func TestReassignPullRequest(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2", "u3")
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	require.Len(t, pullRequest.AssignedReviewers, 1)
+	oldReviewer := pullRequest.AssignedReviewers[0]
+
+	pullRequest, newReviewer, err := a.ReassignPullRequest(ctx, "pr-1", oldReviewer)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldReviewer, newReviewer)
+	assert.NotEqual(t, "u1", newReviewer)
+	assert.Equal(t, []string{newReviewer}, pullRequest.AssignedReviewers)
+
+	// Снятый ревьювер остаётся в истории
+	history, err := a.GetPullRequestHistory(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, oldReviewer, history[0].ReviewerID)
+	assert.False(t, history[0].UnassignedAt.IsZero())
+	assert.Equal(t, newReviewer, history[1].ReviewerID)
+	assert.Equal(t, models.ASSIGNMENT_MANUAL_REASSIGN, history[1].Reason)
+
+	// Снятый ревьювер снова становится кандидатом
+	_, returned, err := a.ReassignPullRequest(ctx, "pr-1", newReviewer)
+	require.NoError(t, err)
+	assert.Equal(t, oldReviewer, returned)
+
+	_, _, err = a.ReassignPullRequest(ctx, "pr-1", newReviewer)
+	require.ErrorIs(t, err, ErrNotAssigned)
+}
+
+func TestReassignPullRequest_NoCandidates(t *testing.T) {
+	a, _, _ := newAssignment(t)
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	_, _, err := a.ReassignPullRequest(context.Background(), "pr-1", "u2")
+	require.ErrorIs(t, err, ErrNoCandidates)
+}
+
+func TestReassignTeam_Deactivated(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2", "u3")
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	oldReviewer := pullRequest.AssignedReviewers[0]
+
+	_, err := a.SetIsActive(ctx, oldReviewer, false)
+	require.NoError(t, err)
+
+	reassignments, err := a.ReassignTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, reassignments, 1)
+	assert.Equal(t, "pr-1", reassignments[0].PullRequestID)
+	assert.Equal(t, oldReviewer, reassignments[0].OldReviewer)
+	assert.Equal(t, models.REASSIGNMENT_REASSIGNED, reassignments[0].Outcome)
+
+	pullRequest, err = a.GetPullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{reassignments[0].NewReviewer}, pullRequest.AssignedReviewers)
+}
+
+func TestReassignTeam_Outcomes(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	// u2 - единственный ревьювер, заменить его некем
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+	createPR(t, a, "pr-2", "u1")
+	_, err := a.MergePullRequest(ctx, "pr-2")
+	require.NoError(t, err)
+
+	_, err = a.SetIsActive(ctx, "u2", false)
+	require.NoError(t, err)
+
+	reassignments, err := a.ReassignTeam(ctx, "backend")
+	require.NoError(t, err)
+
+	outcomes := make(map[string]models.ReassignmentOutcome)
+	for _, reassignment := range reassignments {
+		assert.Equal(t, "u2", reassignment.OldReviewer)
+		assert.Empty(t, reassignment.NewReviewer)
+		outcomes[reassignment.PullRequestID] = reassignment.Outcome
+	}
+	assert.Equal(t, map[string]models.ReassignmentOutcome{
+		"pr-1": models.REASSIGNMENT_NO_CANDIDATE,
+		"pr-2": models.REASSIGNMENT_PR_MERGED,
+	}, outcomes)
+}
+
+func TestReassignTask_Outcomes(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2", "u3")
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	oldReviewer := pullRequest.AssignedReviewers[0]
+	createPR(t, a, "pr-2", "u1")
+	_, err := a.MergePullRequest(ctx, "pr-2")
+	require.NoError(t, err)
+
+	reasons := reassignReasons{
+		replace: models.ASSIGNMENT_DEACTIVATION,
+		topUp:   models.ASSIGNMENT_TEAM_REASSIGN,
+	}
+	audit := models.AuditEvent{
+		Operation: models.AUDIT_TEAM_REASSIGN,
+		TeamName:  "backend",
+	}
+
+	// Ревьювера, которого уже заменили, нет среди назначенных
+	_, _, err = a.ReassignPullRequest(ctx, "pr-1", oldReviewer)
+	require.NoError(t, err)
+	outcomes, err := a.reassignTask(ctx, reassignTask{
+		pullRequestID: "pr-1",
+		reviewers:     []string{oldReviewer},
+	}, reasons, audit)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Reassignment{{
+		PullRequestID: "pr-1",
+		OldReviewer:   oldReviewer,
+		Outcome:       models.REASSIGNMENT_ALREADY_REPLACED,
+	}}, outcomes)
+
+	// PR смерджили после того, как были получены ревью
+	outcomes, err = a.reassignTask(ctx, reassignTask{
+		pullRequestID: "pr-2",
+		reviewers:     []string{"u2"},
+	}, reasons, audit)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Reassignment{{
+		PullRequestID: "pr-2",
+		OldReviewer:   "u2",
+		Outcome:       models.REASSIGNMENT_PR_MERGED,
+	}}, outcomes)
+}
+
+func TestReassignTeam_Parallel(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2", "u3")
+	reviewed := 0
+	for i := range 20 {
+		pullRequest := createPR(t, a, fmt.Sprintf("pr-%d", i), "u1")
+		if pullRequest.AssignedReviewers[0] == "u2" {
+			reviewed++
+		}
+	}
+
+	// Все ревью u2 переназначаются на u3 в нескольких горутинах
+	_, err := a.SetIsActive(ctx, "u2", false)
+	require.NoError(t, err)
+
+	reassignments, err := a.ReassignTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, reassignments, reviewed)
+
+	for _, reassignment := range reassignments {
+		assert.Equal(t, models.REASSIGNMENT_REASSIGNED, reassignment.Outcome)
+		assert.Equal(t, "u3", reassignment.NewReviewer)
+
+		pullRequest, err := a.GetPullRequest(ctx, reassignment.PullRequestID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u3"}, pullRequest.AssignedReviewers)
+	}
+}
+
+func TestPreviewReassignTeam_RollsBack(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2", "u3")
+	pullRequest := createPR(t, a, "pr-1", "u1")
+	oldReviewer := pullRequest.AssignedReviewers[0]
+
+	_, err := a.SetIsActive(ctx, oldReviewer, false)
+	require.NoError(t, err)
+
+	filter := models.AuditFilter{PullRequestID: "pr-1", Limit: 10}
+	eventsBefore, _, err := a.ListAuditEvents(ctx, filter, "")
+	require.NoError(t, err)
+
+	preview, err := a.PreviewReassignTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, preview, 1)
+	assert.Equal(t, models.REASSIGNMENT_REASSIGNED, preview[0].Outcome)
+	assert.Equal(t, oldReviewer, preview[0].OldReviewer)
+
+	// Ни ревьюверы, ни журнал не изменились
+	pullRequest, err = a.GetPullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldReviewer}, pullRequest.AssignedReviewers)
+
+	eventsAfter, _, err := a.ListAuditEvents(ctx, filter, "")
+	require.NoError(t, err)
+	assert.Equal(t, eventsBefore, eventsAfter)
+
+	// Настоящее переназначение делает то же, что показал пробный запуск
+	reassignments, err := a.ReassignTeam(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, preview, reassignments)
+}
+
+func TestPreviewDeactivateTeam_RollsBack(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+
+	team, reassignments, err := a.PreviewDeactivateTeam(ctx, "backend")
+	require.NoError(t, err)
+	for _, member := range team.Members {
+		assert.False(t, member.IsActive)
+	}
+	assert.Equal(t, []models.Reassignment{{
+		PullRequestID: "pr-1",
+		OldReviewer:   "u2",
+		Outcome:       models.REASSIGNMENT_NO_CANDIDATE,
+	}}, reassignments)
+	assert.Equal(t, []string{"pr-1"}, models.UnresolvedPullRequests(reassignments))
+
+	// Команда осталась активной
+	team, err = a.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	for _, member := range team.Members {
+		assert.True(t, member.IsActive)
+	}
+
+	_, _, err = a.PreviewDeactivateTeam(ctx, "frontend")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSetMaxOpenReviews_LimitsAssignments(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+
+	limit := 1
+	_, err := a.SetMaxOpenReviews(ctx, "u2", &limit)
+	require.NoError(t, err)
+
+	first := createPR(t, a, "pr-1", "u1")
+	assert.Equal(t, []string{"u2"}, first.AssignedReviewers)
+
+	// u2 загружен до предела, назначить некого
+	second := createPR(t, a, "pr-2", "u1")
+	assert.Empty(t, second.AssignedReviewers)
+	assert.Equal(t, 1, second.ReviewersMissing)
+}
+
+func TestTeamLeaderboard(t *testing.T) {
+	a, _, _ := newAssignment(t)
+	ctx := context.Background()
+
+	addTeam(t, a, "backend", 1, "u1", "u2")
+	createPR(t, a, "pr-1", "u1")
+	createPR(t, a, "pr-2", "u2")
+	_, err := a.MergePullRequest(ctx, "pr-1")
+	require.NoError(t, err)
+
+	leaderboard, err := a.TeamLeaderboard(ctx, "backend", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, leaderboard, 2)
+
+	// u2 ревьюил смердженный PR, поэтому выше
+	assert.Equal(t, "u2", leaderboard[0].UserID)
+	assert.Equal(t, 1, leaderboard[0].MergedReviews)
+	assert.Equal(t, 1, leaderboard[0].PullRequestsAuthored)
+	assert.Equal(t, "u1", leaderboard[1].UserID)
+	assert.Equal(t, 1, leaderboard[1].OpenReviews)
+
+	_, err = a.TeamLeaderboard(ctx, "frontend", time.Time{})
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
 	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
 )
 
 // Создаёт пул реквест
@@ -19,7 +20,10 @@ func (a *PRAssignment) CreatePullRequest(
 ) (models.PullRequest, error) {
 	const op = "service.PRAssignment.CreatePullRequest"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("pull_request_id", pullRequest.ID),
 		slog.String("pull_request_name", pullRequest.Name),
@@ -28,49 +32,236 @@ func (a *PRAssignment) CreatePullRequest(
 	log.Info("Attempting to create PR")
 
 	// Начинаем транзакцию
-	err := a.txManager.Do(ctx, func(ctx context.Context) error {
-		// Создаем пул реквест
-		err := a.prCreator.CreatePullRequest(ctx, pullRequest)
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		pullRequest, err = a.createPullRequest(ctx, log, pullRequest, false)
 		if err != nil {
-			log.Error("Failed to create PR",
-				slog.String("err", err.Error()),
-			)
-			if errors.Is(err, repositories.ErrPRExists) {
-				return ErrPRExists
+			return err
+		}
+
+		log.Info("PR successfully created")
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	return pullRequest, nil
+}
+
+// Создаёт пачку пул реквестов одной транзакцией. Если какой-то из них
+// создать не удалось, транзакция откатывается и каждый пул реквест
+// создаётся в своей транзакции. Итоги возвращаются в порядке пул
+// реквестов. С keepReviewers ревьюверами назначаются переданные в
+// AssignedReviewers, а если их нет - как при создании одного.
+// Ошибка возвращается, только если ни один пул реквест не создан
+func (a *PRAssignment) CreatePullRequests(
+	ctx context.Context,
+	pullRequests []models.PullRequest,
+	keepReviewers bool,
+) ([]models.PullRequestCreateResult, error) {
+	const op = "service.PRAssignment.CreatePullRequests"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.Int("count", len(pullRequests)),
+		slog.Bool("keep_reviewers", keepReviewers),
+	)
+
+	log.Info("Attempting to create PRs")
+
+	// Некорректные пул реквесты не создаются
+	results := make([]models.PullRequestCreateResult, len(pullRequests))
+	valid := make([]int, 0, len(pullRequests))
+	for i := range pullRequests {
+		results[i].PullRequest = pullRequests[i]
+		results[i].Err = validatePullRequest(&pullRequests[i], keepReviewers)
+		if results[i].Err == nil {
+			valid = append(valid, i)
+		}
+	}
+
+	// Пробуем создать всю пачку одной транзакцией
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		for _, i := range valid {
+			log := log.With(slog.String("pull_request_id", pullRequests[i].ID))
+			pullRequest, err := a.createPullRequest(ctx, log, pullRequests[i], keepReviewers)
+			if err != nil {
+				return err
 			}
-			if errors.Is(err, repositories.ErrNotFound) {
-				return ErrNotFound
+
+			results[i].PullRequest = pullRequest
+		}
+
+		return nil
+	})
+	if err != nil && !isCreateFailure(err) {
+		log.Error("Failed to create PRs",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Пачка откатилась, поэтому создаём пул реквесты по одному, чтобы
+	// узнать итог каждого
+	if err != nil {
+		log.Warn("Batch failed, creating PRs one by one",
+			slog.String("err", err.Error()),
+		)
+
+		for _, i := range valid {
+			log := log.With(slog.String("pull_request_id", pullRequests[i].ID))
+			results[i].PullRequest = pullRequests[i]
+			results[i].Err = a.inTx(ctx, func(ctx context.Context) error {
+				pullRequest, err := a.createPullRequest(ctx, log, pullRequests[i], keepReviewers)
+				if err != nil {
+					return err
+				}
+
+				results[i].PullRequest = pullRequest
+				return nil
+			})
+			// Предыдущие пул реквесты уже созданы, поэтому ошибка
+			// остаётся итогом этого пул реквеста, а не всей пачки
+			if results[i].Err != nil && !isCreateFailure(results[i].Err) {
+				log.Error("Failed to create PR",
+					slog.String("err", results[i].Err.Error()),
+				)
+
+				results[i].Err = fmt.Errorf("%s: %w", op, results[i].Err)
 			}
+		}
+	}
 
-			return fmt.Errorf("%s: %w", op, err)
+	log.Info("PRs created successfully")
+
+	return results, nil
+}
+
+// Проверяет пул реквест перед созданием пачкой. Ревьюверы
+// проверяются, только если они сохраняются как есть
+func validatePullRequest(pullRequest *models.PullRequest, keepReviewers bool) error {
+	if pullRequest.ID == "" || pullRequest.AuthorID == "" {
+		return ErrInvalidPullRequest
+	}
+	if !keepReviewers {
+		return nil
+	}
+
+	for i, reviewerID := range pullRequest.AssignedReviewers {
+		if reviewerID == pullRequest.AuthorID || slices.Contains(pullRequest.AssignedReviewers[:i], reviewerID) {
+			return ErrInvalidReviewers
 		}
+	}
 
-		// Назначаем ревьюверов
-		err = a.revAssigner.AssignReviewers(ctx, pullRequest.ID, pullRequest.AuthorID)
+	return nil
+}
+
+// Ошибка, из-за которой не создан только этот пул реквест
+func isCreateFailure(err error) bool {
+	return errors.Is(err, ErrPRExists) ||
+		errors.Is(err, ErrNotFound) ||
+		errors.Is(err, ErrInvalidReviewers)
+}
+
+// Создаёт пул реквест, назначает ревьюверов, публикует события и
+// записывает изменение в журнал. Вызывается внутри транзакции
+func (a *PRAssignment) createPullRequest(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequest models.PullRequest,
+	keepReviewers bool,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.createPullRequest"
+
+	// Создаем пул реквест
+	err := a.prCreator.CreatePullRequest(ctx, pullRequest)
+	if err != nil {
+		log.Error("Failed to create PR",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrPRExists) {
+			return models.PullRequest{}, ErrPRExists
+		}
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.PullRequest{}, ErrNotFound
+		}
+
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Переданные ревьюверы назначаются как есть. Иначе (в том числе если
+	// ревьюверы не переданы) назначаем ревьюверов, на черновик они
+	// назначаются при переводе в OPEN
+	reviewers := []string{}
+	switch {
+	case keepReviewers && len(pullRequest.AssignedReviewers) > 0:
+		reviewers = pullRequest.AssignedReviewers
+		err = a.revAssigner.AddReviewers(ctx, pullRequest.ID, reviewers, models.ASSIGNMENT_IMPORT)
 		if err != nil {
-			log.Error("Failed to assign reviewer",
+			log.Error("Failed to add reviewers",
 				slog.String("err", err.Error()),
 			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return models.PullRequest{}, ErrNotFound
+			}
+			if errors.Is(err, repositories.ErrAuthorReviewer) ||
+				errors.Is(err, repositories.ErrReviewerAssigned) {
+				return models.PullRequest{}, ErrInvalidReviewers
+			}
 
-			return fmt.Errorf("%s: %w", op, err)
+			return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
 		}
-
-		// Получаем пул реквест
-		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequest.ID)
+	case pullRequest.Status != models.PULLREQUEST_DRAFT:
+		reviewers, err = a.revAssigner.AssignReviewers(ctx, pullRequest.ID, pullRequest.AuthorID, models.ASSIGNMENT_INITIAL)
 		if err != nil {
-			// Проверять на ErrNotFound нет смысла
-			log.Error("Failed to get PR",
+			log.Error("Failed to assign reviewer",
 				slog.String("err", err.Error()),
 			)
-			return fmt.Errorf("%s: %w", op, err)
+
+			return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
 		}
+	}
 
-		log.Info("PR successfully created")
+	// Получаем пул реквест
+	pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequest.ID)
+	if err != nil {
+		// Проверять на ErrNotFound нет смысла
+		log.Error("Failed to get PR",
+			slog.String("err", err.Error()),
+		)
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
 
-		return nil
-	})
+	// Публикуем события о создании и назначении ревьюверов
+	err = a.publish(ctx, models.EVENT_PR_CREATED, pullRequestEventData(&pullRequest))
+	if err == nil {
+		err = a.publishAssigned(ctx, pullRequest.ID, reviewers)
+	}
 	if err != nil {
-		return models.PullRequest{}, err
+		log.Error("Failed to publish events",
+			slog.String("err", err.Error()),
+		)
+
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Записываем изменение в журнал
+	err = a.audit(ctx, models.AuditEvent{
+		Operation:     models.AUDIT_PR_CREATE,
+		PullRequestID: pullRequest.ID,
+	}, nil, pullRequestSnapshot(&pullRequest))
+	if err != nil {
+		log.Error("Failed to write audit event",
+			slog.String("err", err.Error()),
+		)
+
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return pullRequest, nil
@@ -83,47 +274,139 @@ func (a *PRAssignment) MergePullRequest(
 ) (models.PullRequest, error) {
 	const op = "service.PRAssignment.MergePullRequest"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("pull_request_id", pullRequestID),
 	)
 
 	log.Info("Attempting to merge PR")
 
-	// Получаем пул реквест
-	pullRequest, err := a.prProvider.GetPullRequest(ctx, pullRequestID)
-	if err != nil {
-		log.Error("Failed to get PR",
-			slog.String("err", err.Error()),
-		)
-		if errors.Is(err, repositories.ErrNotFound) {
-			return models.PullRequest{}, ErrNotFound
+	return a.mergePullRequest(ctx, log, pullRequestID, true)
+}
+
+// Помечает пул реквест как MERGED по событию системы контроля версий.
+// Пул реквест уже замерджен во внешней системе, поэтому одобрения
+// ревьюверов не проверяются
+func (a *PRAssignment) MergeExternalPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.MergeExternalPullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to record external PR merge")
+
+	return a.mergePullRequest(ctx, log, pullRequestID, false)
+}
+
+// Мерджит пул реквест в транзакции. С checkApproval пул реквест
+// без нужного числа одобрений не мерджится
+func (a *PRAssignment) mergePullRequest(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequestID string,
+	checkApproval bool,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.mergePullRequest"
+
+	// Начинаем транзакцию
+	var pullRequest models.PullRequest
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пул реквест
+		var err error
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
-	}
+		// Проверяем замерджен ли он уже
+		if pullRequest.Status == models.PULLREQUEST_MERGED {
+			log.Info("PR already merged")
+			return nil
+		}
 
-	// Проверяем замерджен ли он уже
-	if pullRequest.Status == models.PULLREQUEST_MERGED {
-		log.Info("PR already merged")
-		return pullRequest, nil
-	}
+		// Черновик и закрытый пул реквест мерджить нельзя
+		if pullRequest.Status == models.PULLREQUEST_DRAFT {
+			log.Error("Cannot merge draft PR")
 
-	pullRequest.MergedAt = time.Now().Truncate(time.Second)
-	pullRequest.Status = models.PULLREQUEST_MERGED
+			return ErrPRDraft
+		}
+		if pullRequest.Status == models.PULLREQUEST_CLOSED {
+			log.Error("Cannot merge closed PR")
 
-	// Мерджим пул реквест
-	err = a.prModifier.MergePullRequest(ctx, pullRequestID, pullRequest.MergedAt)
-	if err != nil {
-		// Проверять на ErrNotFound нет смысла
-		log.Error("Failed to merge PR",
-			slog.String("err", err.Error()),
-		)
+			return ErrPRClosed
+		}
 
-		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
-	}
+		// Проверяем одобрен ли пул реквест
+		if checkApproval && !a.isApproved(pullRequest) {
+			log.Error("PR is not approved")
+
+			return ErrNotApproved
+		}
+
+		before := pullRequestSnapshot(&pullRequest)
+		pullRequest.MergedAt = time.Now().Truncate(time.Second)
+		pullRequest.Status = models.PULLREQUEST_MERGED
+		pullRequest.ReviewersMissing = 0
+
+		// Мерджим пул реквест
+		err = a.prModifier.MergePullRequest(ctx, pullRequestID, pullRequest.MergedAt)
+		if err != nil {
+			// Проверять на ErrNotFound нет смысла
+			log.Error("Failed to merge PR",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
 
-	log.Info("PR successfully merged")
+		// Публикуем событие о мердже
+		err = a.publish(ctx, models.EVENT_PR_MERGED, pullRequestEventData(&pullRequest))
+		if err != nil {
+			log.Error("Failed to publish event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_MERGE,
+			PullRequestID: pullRequestID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Info("PR successfully merged")
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
 
 	return pullRequest, nil
 }
@@ -136,7 +419,10 @@ func (a *PRAssignment) ReassignPullRequest(
 ) (models.PullRequest, string, error) {
 	const op = "service.PRAssignment.ReassignPullRequest"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("pull_request_id", pullRequestID),
 		slog.String("old_reviewer_id", oldReviewerID),
@@ -147,7 +433,7 @@ func (a *PRAssignment) ReassignPullRequest(
 	// Начинаем транзакцию
 	var pullRequest models.PullRequest
 	var newReviewerID string
-	err := a.txManager.Do(ctx, func(ctx context.Context) error {
+	err := a.inTx(ctx, func(ctx context.Context) error {
 		// Проверяем что пользователя вообще существует
 		_, err := a.userProvider.GetUser(ctx, oldReviewerID)
 		if err != nil {
@@ -175,12 +461,17 @@ func (a *PRAssignment) ReassignPullRequest(
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		// Проверяем что пул реквест не MERGED
+		// Проверяем что пул реквест не MERGED и не CLOSED
 		if pullRequest.Status == models.PULLREQUEST_MERGED {
 			log.Error("Cannot reassign reviewers of merged PR")
 
 			return ErrPRMerged
 		}
+		if pullRequest.Status == models.PULLREQUEST_CLOSED {
+			log.Error("Cannot reassign reviewers of closed PR")
+
+			return ErrPRClosed
+		}
 
 		// Проверяем что юзер назначен ревьювером
 		isReviewer := slices.Contains(pullRequest.AssignedReviewers, oldReviewerID)
@@ -189,9 +480,10 @@ func (a *PRAssignment) ReassignPullRequest(
 
 			return ErrNotAssigned
 		}
+		before := pullRequestSnapshot(&pullRequest)
 
 		// Переназначаем ревьювера
-		newReviewerID, err = a.revModifier.ReassignReviewer(ctx, pullRequestID, oldReviewerID)
+		newReviewerID, err = a.revModifier.ReassignReviewer(ctx, pullRequestID, oldReviewerID, models.ASSIGNMENT_MANUAL_REASSIGN, false)
 		if err != nil {
 			log.Error("Failed to reassign PR reviewer",
 				slog.String("err", err.Error()),
@@ -199,27 +491,661 @@ func (a *PRAssignment) ReassignPullRequest(
 			if errors.Is(err, repositories.ErrNotFound) {
 				return ErrNotFound
 			} else if errors.Is(err, repositories.ErrNoCandidates) {
+				a.metrics.NoCandidates()
 				return ErrNoCandidates
 			}
 
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
+		// Публикуем событие о замене ревьювера
+		err = a.publishReassigned(ctx, pullRequestID, oldReviewerID, newReviewerID)
+		if err != nil {
+			log.Error("Failed to publish event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Переназначаем ревьювера в нашем пул реквесте (чтобы не брать его снова из БД)
+		for i, reviewers := range pullRequest.AssignedReviewers {
+			if reviewers == oldReviewerID {
+				pullRequest.AssignedReviewers[i] = newReviewerID
+				break
+			}
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_REASSIGN,
+			PullRequestID: pullRequestID,
+			UserID:        oldReviewerID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
 		return nil
 	})
 	if err != nil {
 		return models.PullRequest{}, "", err
 	}
 
-	// Переназначаем ревьювера в нашем пул реквесте (чтобы не брать его снова из БД)
-	for i, reviewers := range pullRequest.AssignedReviewers {
-		if reviewers == oldReviewerID {
-			pullRequest.AssignedReviewers[i] = newReviewerID
-			break
-		}
-	}
-
 	log.Info("Reviewer successfully reassigned")
 
 	return pullRequest, newReviewerID, nil
 }
+
+// Сохраняет вердикт ревьювера пул реквеста
+func (a *PRAssignment) ReviewPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerID string,
+	verdict models.ReviewVerdict,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.ReviewPullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+		slog.String("reviewer_id", reviewerID),
+		slog.String("verdict", verdict),
+	)
+
+	log.Info("Attempting to review PR")
+
+	// Проверяем вердикт
+	switch verdict {
+	case models.REVIEW_APPROVED, models.REVIEW_CHANGES_REQUESTED, models.REVIEW_COMMENTED:
+	default:
+		log.Error("Unknown review verdict")
+
+		return models.PullRequest{}, ErrInvalidVerdict
+	}
+
+	// Начинаем транзакцию
+	var pullRequest models.PullRequest
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Проверяем что пользователь вообще существует
+		_, err := a.userProvider.GetUser(ctx, reviewerID)
+		if err != nil {
+			log.Error("Failed to get reviewer",
+				slog.String("err", err.Error()),
+			)
+
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем пул реквест
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Проверяем что пул реквест не MERGED и не CLOSED
+		if pullRequest.Status == models.PULLREQUEST_MERGED {
+			log.Error("Cannot review merged PR")
+
+			return ErrPRMerged
+		}
+		if pullRequest.Status == models.PULLREQUEST_CLOSED {
+			log.Error("Cannot review closed PR")
+
+			return ErrPRClosed
+		}
+
+		// Проверяем что юзер назначен ревьювером
+		isReviewer := slices.Contains(pullRequest.AssignedReviewers, reviewerID)
+		if !isReviewer {
+			log.Error("Reviewer is not assigned to this PR")
+
+			return ErrNotAssigned
+		}
+		before := pullRequestSnapshot(&pullRequest)
+
+		// Сохраняем вердикт
+		err = a.revModifier.SetVerdict(ctx, pullRequestID, reviewerID, verdict)
+		if err != nil {
+			// Проверять на ErrNotFound нет смысла
+			log.Error("Failed to set verdict",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем обновлённый пул реквест
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_REVIEW,
+			PullRequestID: pullRequestID,
+			UserID:        reviewerID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	log.Info("PR successfully reviewed")
+
+	return pullRequest, nil
+}
+
+// Переводит пул реквест из DRAFT в OPEN и назначает ревьюверов
+func (a *PRAssignment) MarkReady(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.MarkReady"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to mark PR as ready")
+
+	// Начинаем транзакцию
+	var pullRequest models.PullRequest
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пул реквест
+		var err error
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		switch pullRequest.Status {
+		case models.PULLREQUEST_OPEN:
+			log.Info("PR already open")
+			return nil
+		case models.PULLREQUEST_MERGED:
+			log.Error("Cannot mark merged PR as ready")
+			return ErrPRMerged
+		case models.PULLREQUEST_CLOSED:
+			log.Error("Cannot mark closed PR as ready")
+			return ErrPRClosed
+		}
+		before := pullRequestSnapshot(&pullRequest)
+
+		// Открываем пул реквест
+		err = a.prModifier.SetStatus(ctx, pullRequestID, models.PULLREQUEST_OPEN)
+		if err != nil {
+			log.Error("Failed to set PR status",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Назначаем ревьюверов
+		err = a.assignReviewers(ctx, pullRequestID, pullRequest.AuthorID, models.ASSIGNMENT_INITIAL)
+		if err != nil {
+			log.Error("Failed to assign reviewer",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем обновлённый пул реквест
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_MARK_READY,
+			PullRequestID: pullRequestID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	log.Info("PR successfully marked as ready")
+
+	return pullRequest, nil
+}
+
+// Закрывает пул реквест без мерджа
+func (a *PRAssignment) ClosePullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.ClosePullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to close PR")
+
+	// Начинаем транзакцию
+	var pullRequest models.PullRequest
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пул реквест
+		var err error
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		switch pullRequest.Status {
+		case models.PULLREQUEST_CLOSED:
+			log.Info("PR already closed")
+			return nil
+		case models.PULLREQUEST_MERGED:
+			log.Error("Cannot close merged PR")
+			return ErrPRMerged
+		}
+		before := pullRequestSnapshot(&pullRequest)
+
+		// Закрываем пул реквест
+		err = a.prModifier.SetStatus(ctx, pullRequestID, models.PULLREQUEST_CLOSED)
+		if err != nil {
+			log.Error("Failed to set PR status",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		pullRequest.Status = models.PULLREQUEST_CLOSED
+		pullRequest.ReviewersMissing = 0
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_CLOSE,
+			PullRequestID: pullRequestID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	log.Info("PR successfully closed")
+
+	return pullRequest, nil
+}
+
+// Переоткрывает закрытый пул реквест и доназначает ревьюверов
+func (a *PRAssignment) ReopenPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.ReopenPullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to reopen PR")
+
+	// Начинаем транзакцию
+	var pullRequest models.PullRequest
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пул реквест
+		var err error
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		switch pullRequest.Status {
+		case models.PULLREQUEST_OPEN, models.PULLREQUEST_DRAFT:
+			log.Info("PR is not closed")
+			return nil
+		case models.PULLREQUEST_MERGED:
+			log.Error("Cannot reopen merged PR")
+			return ErrPRMerged
+		}
+		before := pullRequestSnapshot(&pullRequest)
+
+		// Открываем пул реквест
+		err = a.prModifier.SetStatus(ctx, pullRequestID, models.PULLREQUEST_OPEN)
+		if err != nil {
+			log.Error("Failed to set PR status",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Доназначаем ревьюверов, например если PR был закрыт из DRAFT
+		err = a.assignReviewers(ctx, pullRequestID, pullRequest.AuthorID, models.ASSIGNMENT_INITIAL)
+		if err != nil {
+			log.Error("Failed to assign reviewer",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем обновлённый пул реквест
+		pullRequest, err = a.prProvider.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			log.Error("Failed to get PR",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation:     models.AUDIT_PR_REOPEN,
+			PullRequestID: pullRequestID,
+		}, before, pullRequestSnapshot(&pullRequest))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	log.Info("PR successfully reopened")
+
+	return pullRequest, nil
+}
+
+// Получает пул реквест с назначенными ревьюверами
+func (a *PRAssignment) GetPullRequest(
+	ctx context.Context,
+	pullRequestID string,
+) (models.PullRequest, error) {
+	const op = "service.PRAssignment.GetPullRequest"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to get PR")
+
+	// Получаем пул реквест
+	pullRequest, err := a.prProvider.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		log.Error("Failed to get PR",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.PullRequest{}, ErrNotFound
+		}
+
+		return models.PullRequest{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got PR successfully")
+
+	return pullRequest, nil
+}
+
+// Получает историю назначений ревьюверов пул реквеста
+func (a *PRAssignment) GetPullRequestHistory(
+	ctx context.Context,
+	pullRequestID string,
+) ([]models.ReviewerAssignment, error) {
+	const op = "service.PRAssignment.GetPullRequestHistory"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+	)
+
+	log.Info("Attempting to get PR history")
+
+	history, err := a.prProvider.GetReviewerHistory(ctx, pullRequestID)
+	if err != nil {
+		log.Error("Failed to get PR history",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got PR history successfully")
+
+	return history, nil
+}
+
+// Максимальный размер страницы списка
+const maxPageSize = 100
+
+// Получает страницу списка пул реквестов и курсор следующей страницы
+func (a *PRAssignment) ListPullRequests(
+	ctx context.Context,
+	filter models.PullRequestFilter,
+	cursor string,
+) ([]models.PullRequest, string, error) {
+	const op = "service.PRAssignment.ListPullRequests"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.Int("limit", filter.Limit),
+	)
+
+	log.Info("Attempting to list PRs")
+
+	// Проверяем параметры
+	if filter.Limit < 1 || filter.Limit > maxPageSize {
+		log.Error("Invalid page size")
+
+		return nil, "", ErrInvalidLimit
+	}
+	switch filter.SortBy {
+	case models.PULLREQUEST_SORT_CREATED_AT, models.PULLREQUEST_SORT_MERGED_AT:
+	default:
+		log.Error("Invalid sort field")
+
+		return nil, "", ErrInvalidFilter
+	}
+	switch filter.Status {
+	case "", models.PULLREQUEST_DRAFT, models.PULLREQUEST_OPEN,
+		models.PULLREQUEST_MERGED, models.PULLREQUEST_CLOSED:
+	default:
+		log.Error("Invalid status filter")
+
+		return nil, "", ErrInvalidFilter
+	}
+
+	// Декодируем позицию предыдущей страницы
+	if cursor != "" {
+		var after models.PullRequestCursor
+		err := decodeCursor(cursor, &after)
+		if err != nil {
+			log.Error("Failed to decode cursor")
+
+			return nil, "", err
+		}
+
+		// Курсор получен для другого порядка, и граница страницы не совпадёт
+		if after.SortBy != filter.SortBy || after.Descending != filter.Descending {
+			log.Error("Cursor sort order mismatch")
+
+			return nil, "", ErrInvalidCursor
+		}
+		filter.After = &after
+	}
+
+	// Берём на один элемент больше, чтобы узнать есть ли следующая страница
+	pageSize := filter.Limit
+	filter.Limit++
+
+	pullRequests, err := a.prProvider.ListPullRequests(ctx, filter)
+	if err != nil {
+		log.Error("Failed to list PRs",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Последняя страница
+	if len(pullRequests) <= pageSize {
+		log.Info("Listed PRs successfully")
+
+		return pullRequests, "", nil
+	}
+
+	// Курсор указывает на последний PR страницы
+	pullRequests = pullRequests[:pageSize]
+	last := pullRequests[pageSize-1]
+	position := models.PullRequestCursor{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		SortValue:  last.CreatedAt,
+		ID:         last.ID,
+	}
+	if filter.SortBy == models.PULLREQUEST_SORT_MERGED_AT {
+		position.SortValue = time.Time{}
+		if last.Status == models.PULLREQUEST_MERGED {
+			position.SortValue = last.MergedAt
+		}
+	}
+
+	nextCursor, err := encodeCursor(position)
+	if err != nil {
+		log.Error("Failed to encode cursor",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Listed PRs successfully")
+
+	return pullRequests, nextCursor, nil
+}
+
+// Проверяет, что у пул реквеста достаточно одобрений
+// и никто из ревьюверов не запросил изменения
+func (a *PRAssignment) isApproved(pullRequest models.PullRequest) bool {
+	// Одобрения не требуются
+	if a.requiredApprovals <= 0 {
+		return true
+	}
+
+	approvals := 0
+	for _, review := range pullRequest.Reviews {
+		switch review.Verdict {
+		case models.REVIEW_CHANGES_REQUESTED:
+			return false
+		case models.REVIEW_APPROVED:
+			approvals++
+		}
+	}
+
+	return approvals >= a.requiredApprovals
+}
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
 	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -18,17 +21,38 @@ func (a *PRAssignment) AddTeam(
 ) (models.Team, error) {
 	const op = "service.PRAssignment.AddTeam"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("team_name", team.TeamName),
 	)
 
 	log.Info("Attempting to add team")
 
+	// Проверяем количество ревьюверов
+	if team.ReviewersCount < 1 {
+		log.Error("Invalid reviewers count")
+
+		return models.Team{}, ErrInvalidReviewersCount
+	}
+
+	// Проверяем ограничения открытых ревью
+	for _, user := range team.Members {
+		if user.MaxOpenReviews != nil && *user.MaxOpenReviews < 0 {
+			log.Error("Invalid max open reviews",
+				slog.String("user_id", user.UserID),
+			)
+
+			return models.Team{}, ErrInvalidMaxOpenReviews
+		}
+	}
+
 	// Начинаем транзакцию
-	err := a.txManager.Do(ctx, func(ctx context.Context) error {
+	err := a.inTx(ctx, func(ctx context.Context) error {
 		// Вставляем саму команду в БД
-		teamID, err := a.teamCreator.AddTeam(ctx, team.TeamName)
+		teamID, err := a.teamCreator.AddTeam(ctx, team.TeamName, team.ReviewersCount)
 		if err != nil {
 			log.Error("Failed to add team",
 				slog.String("err", err.Error()),
@@ -54,6 +78,19 @@ func (a *PRAssignment) AddTeam(
 			}
 		}
 
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_TEAM_ADD,
+			TeamName:  team.TeamName,
+		}, nil, teamSnapshot(&team))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -72,7 +109,10 @@ func (a *PRAssignment) GetTeam(
 ) (models.Team, error) {
 	const op = "service.PRAssignment.GetTeam"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("team_name", teamName),
 	)
@@ -104,34 +144,82 @@ func (a *PRAssignment) DeactivateTeam(
 ) (models.Team, error) {
 	const op = "service.PRAssignment.DeactivateTeam"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("team_name", teamName),
 	)
 
 	log.Info("Attempting to deactivate team")
 
-	// Деактивируем команду
-	err := a.teamModifier.DeactivateTeam(ctx, teamName)
-	if err != nil {
-		log.Error("Failed to deactivate team",
-			slog.String("err", err.Error()),
-		)
+	// Начинаем транзакцию
+	var team models.Team
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем команду, чтобы узнать кто был активным
+		var err error
+		team, err = a.teamProvider.GetTeam(ctx, teamName)
+		if err != nil {
+			log.Error("Failed to get team",
+				slog.String("err", err.Error()),
+			)
 
-		if errors.Is(err, repositories.ErrNotFound) {
-			return models.Team{}, ErrNotFound
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		return models.Team{}, fmt.Errorf("%s: %w", op, err)
-	}
+		before := teamSnapshot(&team)
 
-	// Получаем команду
-	team, err := a.teamProvider.GetTeam(ctx, teamName)
-	if err != nil {
-		log.Error("Failed to get team",
-			slog.String("err", err.Error()),
-		)
+		// Деактивируем команду
+		err = a.teamModifier.DeactivateTeam(ctx, teamName)
+		if err != nil {
+			log.Error("Failed to deactivate team",
+				slog.String("err", err.Error()),
+			)
 
-		return models.Team{}, fmt.Errorf("%s: %w", op, err)
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Публикуем события о деактивации бывших активными пользователей
+		for i, member := range team.Members {
+			if !member.IsActive {
+				continue
+			}
+
+			err = a.publish(ctx, models.EVENT_USER_DEACTIVATED, models.UserEventData{
+				UserID:   member.UserID,
+				TeamName: teamName,
+			})
+			if err != nil {
+				log.Error("Failed to publish event",
+					slog.String("err", err.Error()),
+				)
+
+				return fmt.Errorf("%s: %w", op, err)
+			}
+
+			team.Members[i].IsActive = false
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_TEAM_DEACTIVATE,
+			TeamName:  teamName,
+		}, before, teamSnapshot(&team))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.Team{}, err
 	}
 
 	log.Info("Successfully deactivated team")
@@ -139,13 +227,106 @@ func (a *PRAssignment) DeactivateTeam(
 	return team, nil
 }
 
+// Обновляет настройки команды
+func (a *PRAssignment) UpdateTeam(
+	ctx context.Context,
+	teamName string,
+	reviewersCount int,
+) (models.Team, error) {
+	const op = "service.PRAssignment.UpdateTeam"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Int("reviewers_count", reviewersCount),
+	)
+
+	log.Info("Attempting to update team")
+
+	// Проверяем количество ревьюверов
+	if reviewersCount < 1 {
+		log.Error("Invalid reviewers count")
+
+		return models.Team{}, ErrInvalidReviewersCount
+	}
+
+	// Начинаем транзакцию
+	var team models.Team
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем команду до изменения
+		before, err := a.teamProvider.GetTeam(ctx, teamName)
+		if err != nil {
+			log.Error("Failed to get team",
+				slog.String("err", err.Error()),
+			)
+
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Обновляем команду
+		err = a.teamModifier.UpdateTeam(ctx, teamName, reviewersCount)
+		if err != nil {
+			log.Error("Failed to update team",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем команду
+		team, err = a.teamProvider.GetTeam(ctx, teamName)
+		if err != nil {
+			log.Error("Failed to get team",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_TEAM_UPDATE,
+			TeamName:  teamName,
+		}, teamSnapshot(&before), teamSnapshot(&team))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.Team{}, err
+	}
+
+	log.Info("Successfully updated team")
+
+	return team, nil
+}
+
+// Переназначает неактивных членов команды во всех открытых пул реквестах
+// и доназначает ревьюверов до количества, заданного командой автора.
+// Возвращает итог по каждому ревью неактивных членов, в том числе
+// по тем, которые не удалось или не нужно было переназначать
 func (a *PRAssignment) ReassignTeam(
 	ctx context.Context,
 	teamName string,
 ) ([]models.Reassignment, error) {
 	const op = "service.PRAssignment.ReassignTeam"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("team_name", teamName),
 	)
@@ -165,61 +346,318 @@ func (a *PRAssignment) ReassignTeam(
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Проходимся по каждом члену команды и если он неактивный, то переназначаем
-	// его во всех пул реквестах где он ревьювер
-	reassignments := make([]models.Reassignment, 0)
+	// Переназначаем неактивных членов команды во всех пул реквестах,
+	// где они ревьюверы
+	inactive := make([]string, 0)
 	for _, member := range team.Members {
 		if !member.IsActive {
-			pullRequests, err := a.GetReview(ctx, member.UserID)
-			if err != nil {
-				log.Error("Failed to get pull requests",
-					slog.String("err", err.Error()),
-				)
+			inactive = append(inactive, member.UserID)
+		}
+	}
+
+	reassignments, err := a.reassignReviews(ctx, inactive, reassignReasons{
+		replace: models.ASSIGNMENT_DEACTIVATION,
+		topUp:   models.ASSIGNMENT_TEAM_REASSIGN,
+	}, models.AuditEvent{
+		Operation: models.AUDIT_TEAM_REASSIGN,
+		TeamName:  teamName,
+	})
+	if err != nil {
+		log.Error("Failed to reassign inactive team members",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-				return nil, fmt.Errorf("%s: %w", op, err)
+	log.Info("Reassigned successfully")
+
+	return reassignments, nil
+}
+
+// Показывает, как деактивация команды и последующее переназначение
+// её неактивных членов изменят ревью, ничего не сохраняя
+func (a *PRAssignment) PreviewDeactivateTeam(
+	ctx context.Context,
+	teamName string,
+) (models.Team, []models.Reassignment, error) {
+	const op = "service.PRAssignment.PreviewDeactivateTeam"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	log.Info("Attempting to preview team deactivation")
+
+	var team models.Team
+	var reassignments []models.Reassignment
+	err := a.dryRun(ctx, func(ctx context.Context, preview *PRAssignment) error {
+		var err error
+		team, err = preview.DeactivateTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		reassignments, err = preview.ReassignTeam(ctx, teamName)
+		return err
+	})
+	if errors.Is(err, ErrNotFound) {
+		return models.Team{}, nil, ErrNotFound
+	}
+	if err != nil {
+		log.Error("Failed to preview team deactivation",
+			slog.String("err", err.Error()),
+		)
+
+		return models.Team{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Previewed team deactivation successfully")
+
+	return team, reassignments, nil
+}
+
+// Показывает, как переназначение неактивных членов команды
+// изменит ревью, ничего не сохраняя
+func (a *PRAssignment) PreviewReassignTeam(
+	ctx context.Context,
+	teamName string,
+) ([]models.Reassignment, error) {
+	const op = "service.PRAssignment.PreviewReassignTeam"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	log.Info("Attempting to preview team reassignment")
+
+	var reassignments []models.Reassignment
+	err := a.dryRun(ctx, func(ctx context.Context, preview *PRAssignment) error {
+		var err error
+		reassignments, err = preview.ReassignTeam(ctx, teamName)
+		return err
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		log.Error("Failed to preview team reassignment",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Previewed team reassignment successfully")
+
+	return reassignments, nil
+}
+
+// Причины назначений при переназначении пользователя
+type reassignReasons struct {
+	replace models.AssignmentReason // Ревьювер вместо снятого пользователя
+	topUp   models.AssignmentReason // Доназначенные до количества, заданного командой
+}
+
+// Пул реквест и его ревьюверы, которых нужно заменить
+type reassignTask struct {
+	pullRequestID string
+	reviewers     []string
+}
+
+// Переназначает пользователей во всех открытых пул реквестах, где они
+// ревьюверы, и доназначает ревьюверов до количества, заданного командой
+// автора. Изменение каждого пул реквеста записывается в журнал по
+// шаблону audit. Пул реквесты обрабатываются параллельно, не больше
+// reassignParallelism одновременно. Смердженные и закрытые пул реквесты
+// не меняются, но попадают в итоги
+func (a *PRAssignment) reassignReviews(
+	ctx context.Context,
+	userIDs []string,
+	reasons reassignReasons,
+	audit models.AuditEvent,
+) ([]models.Reassignment, error) {
+	// Группируем ревью по пул реквестам, чтобы каждый пул реквест менялся
+	// одной транзакцией и параллельные транзакции не доназначали в него
+	// ревьюверов одновременно
+	tasks := make([]reassignTask, 0)
+	taskIndex := make(map[string]int)
+	skipped := make([]models.Reassignment, 0)
+	for _, userID := range userIDs {
+		pullRequests, err := a.prProvider.GetReview(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Смердженные и закрытые пул реквесты не переназначаются, поэтому
+		// транзакция для них не нужна. Если пул реквест закроют позже, это
+		// проверяется уже в транзакции
+		for _, pr := range pullRequests {
+			if pr.Status != models.PULLREQUEST_OPEN {
+				skipped = append(skipped, notOpenReassignments(pr.ID, pr.Status, []string{userID})...)
+				continue
 			}
 
-			// Пытаемся переназначить
-			errGroup, errCtx := errgroup.WithContext(ctx)
-			for _, pr := range pullRequests {
-				if pr.Status == models.PULLREQUEST_OPEN {
-					errGroup.Go(func() error {
-						// Начинаем транзакцию
-						return a.txManager.Do(errCtx, func(ctx context.Context) error {
-							newReviewer, err := a.revModifier.ReassignReviewer(ctx, pr.ID, member.UserID)
-							// Если не найден подходящий кандидат на замену то ничего не делаем
-							if errors.Is(err, repositories.ErrNoCandidates) {
-								return nil
-							}
-							if err != nil {
-								return err
-							}
-
-							reassignments = append(reassignments, models.Reassignment{
-								OldReviewer: member.UserID,
-								NewReviewer: newReviewer,
-							})
-							return nil
-						})
-					})
-				}
+			i, ok := taskIndex[pr.ID]
+			if !ok {
+				i = len(tasks)
+				taskIndex[pr.ID] = i
+				tasks = append(tasks, reassignTask{pullRequestID: pr.ID})
 			}
 
-			// Ждем завершения всех переназначений
-			err = errGroup.Wait()
+			tasks[i].reviewers = append(tasks[i].reviewers, userID)
+		}
+	}
+
+	// Каждая горутина пишет только итоги своего пул реквеста,
+	// поэтому результаты собираются без блокировок
+	outcomes := make([][]models.Reassignment, len(tasks))
+	errGroup, errCtx := errgroup.WithContext(ctx)
+	errGroup.SetLimit(a.reassignParallelism)
+	for i, task := range tasks {
+		errGroup.Go(func() error {
+			var err error
+			outcomes[i], err = a.reassignTask(errCtx, task, reasons, audit)
+			return err
+		})
+	}
+
+	// Ждем завершения всех переназначений
+	err := errGroup.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(skipped, slices.Concat(outcomes...)...), nil
+}
+
+// Итоги ревьюверов пул реквеста, который не открыт и поэтому не меняется
+func notOpenReassignments(
+	pullRequestID string,
+	status models.PRStatus,
+	reviewers []string,
+) []models.Reassignment {
+	outcome := models.REASSIGNMENT_PR_NOT_OPEN
+	if status == models.PULLREQUEST_MERGED {
+		outcome = models.REASSIGNMENT_PR_MERGED
+	}
+
+	reassignments := make([]models.Reassignment, len(reviewers))
+	for i, reviewer := range reviewers {
+		reassignments[i] = models.Reassignment{
+			PullRequestID: pullRequestID,
+			OldReviewer:   reviewer,
+			Outcome:       outcome,
+		}
+	}
+
+	return reassignments
+}
+
+// Заменяет ревьюверов пул реквеста в одной транзакции и доназначает
+// ревьюверов, если их меньше чем задано командой автора
+func (a *PRAssignment) reassignTask(
+	ctx context.Context,
+	task reassignTask,
+	reasons reassignReasons,
+	audit models.AuditEvent,
+) ([]models.Reassignment, error) {
+	var outcomes []models.Reassignment
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		outcomes = make([]models.Reassignment, 0, len(task.reviewers))
+
+		// Получаем пул реквест до изменения. Его статус мог поменяться
+		// с тех пор, как были получены ревью
+		before, err := a.prProvider.GetPullRequest(ctx, task.pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		// Пул реквест смерджили или закрыли, пока шло переназначение
+		if before.Status != models.PULLREQUEST_OPEN {
+			outcomes = notOpenReassignments(task.pullRequestID, before.Status, task.reviewers)
+			return nil
+		}
+
+		for _, reviewer := range task.reviewers {
+			// Пул реквесты обрабатываются параллельно, поэтому кандидатов,
+			// которых уже назначает другая транзакция, пропускаем
+			newReviewer, err := a.revModifier.ReassignReviewer(ctx, task.pullRequestID, reviewer, reasons.replace, true)
+			// Ревьювера уже заменила параллельная транзакция
+			if errors.Is(err, repositories.ErrNotFound) {
+				outcomes = append(outcomes, models.Reassignment{
+					PullRequestID: task.pullRequestID,
+					OldReviewer:   reviewer,
+					Outcome:       models.REASSIGNMENT_ALREADY_REPLACED,
+				})
+				continue
+			}
+			// Если не найден подходящий кандидат на замену то не переназначаем
+			if errors.Is(err, repositories.ErrNoCandidates) {
+				a.metricsFor(ctx).NoCandidates()
+
+				outcomes = append(outcomes, models.Reassignment{
+					PullRequestID: task.pullRequestID,
+					OldReviewer:   reviewer,
+					Outcome:       models.REASSIGNMENT_NO_CANDIDATE,
+				})
+				continue
+			}
 			if err != nil {
-				log.Error("Failed to reassign inactive team members",
-					slog.String("err", err.Error()),
-				)
+				return err
+			}
+
+			outcomes = append(outcomes, models.Reassignment{
+				PullRequestID: task.pullRequestID,
+				OldReviewer:   reviewer,
+				NewReviewer:   newReviewer,
+				Outcome:       models.REASSIGNMENT_REASSIGNED,
+			})
 
-				return nil, fmt.Errorf("%s: %w", op, err)
+			err = a.publishReassigned(ctx, task.pullRequestID, reviewer, newReviewer)
+			if err != nil {
+				return err
 			}
 		}
-	}
 
-	log.Info("Reassigned successfully")
+		// Доназначаем ревьюверов, если их меньше чем задано командой
+		err = a.assignReviewers(ctx, task.pullRequestID, before.AuthorID, reasons.topUp)
+		if err != nil {
+			return err
+		}
 
-	return reassignments, nil
+		// Записываем изменение в журнал, если ревьюверы поменялись
+		after, err := a.prProvider.GetPullRequest(ctx, task.pullRequestID)
+		if err != nil {
+			return err
+		}
+		beforeSnapshot := pullRequestSnapshot(&before)
+		afterSnapshot := pullRequestSnapshot(&after)
+		if slices.Equal(beforeSnapshot.AssignedReviewers, afterSnapshot.AssignedReviewers) {
+			return nil
+		}
+
+		// Пользователь указывается, если заменялся один ревьювер
+		event := audit
+		event.PullRequestID = task.pullRequestID
+		if len(task.reviewers) == 1 {
+			event.UserID = task.reviewers[0]
+		}
+		return a.audit(ctx, event, beforeSnapshot, afterSnapshot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outcomes, nil
 }
 
 // Получает статистику команды
@@ -229,7 +667,10 @@ func (a *PRAssignment) TeamStats(
 ) (models.TeamStats, error) {
 	const op = "service.PRAssignment.TeamStats"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("team_name", teamName),
 	)
@@ -263,7 +704,7 @@ func (a *PRAssignment) TeamStats(
 	stats.InactiveUsers = stats.Users - stats.ActiveUsers
 
 	// Получаем статистику пул реквестов
-	stats.PullRequests, stats.OpenPullRequests, stats.MergedPullRequests, err = a.teamStatistics.GetTeamsPullRequests(ctx, teamName)
+	statuses, err := a.teamStatistics.GetTeamsPullRequests(ctx, teamName)
 	if err != nil {
 		log.Error("Failed to get team PR stats",
 			slog.String("err", err.Error()),
@@ -271,7 +712,150 @@ func (a *PRAssignment) TeamStats(
 		return models.TeamStats{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Закрытые пул реквесты, как и смердженные, не считаются открытыми
+	stats.DraftPullRequests = statuses[models.PULLREQUEST_DRAFT]
+	stats.OpenPullRequests = statuses[models.PULLREQUEST_OPEN]
+	stats.MergedPullRequests = statuses[models.PULLREQUEST_MERGED]
+	stats.ClosedPullRequests = statuses[models.PULLREQUEST_CLOSED]
+	for _, count := range statuses {
+		stats.PullRequests += count
+	}
+
 	log.Info("Got team stats successfully")
 
 	return stats, nil
 }
+
+// Получает временную статистику команды: перцентили времени до мерджа,
+// распределение возраста открытых PR и самые старые из них
+func (a *PRAssignment) TeamTimingStats(
+	ctx context.Context,
+	teamName string,
+	filter models.TimingFilter,
+) (models.TeamTimingStats, error) {
+	const op = "service.PRAssignment.TeamTimingStats"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	log.Info("Attempting to get team timing stats")
+
+	// По умолчанию окно заканчивается сейчас
+	now := time.Now()
+	if filter.To.IsZero() {
+		filter.To = now
+	}
+
+	// Проверяем параметры
+	if filter.OldestLimit < 1 || filter.OldestLimit > maxPageSize {
+		log.Error("Invalid oldest PRs limit")
+
+		return models.TeamTimingStats{}, ErrInvalidLimit
+	}
+	if !filter.From.IsZero() && !filter.To.After(filter.From) {
+		log.Error("Invalid time range")
+
+		return models.TeamTimingStats{}, ErrInvalidFilter
+	}
+
+	// Проверяем что команда существует
+	_, err := a.teamProvider.GetTeam(ctx, teamName)
+	if err != nil {
+		log.Error("Failed to get team",
+			slog.String("err", err.Error()),
+		)
+
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.TeamTimingStats{}, ErrNotFound
+		}
+		return models.TeamTimingStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats := models.TeamTimingStats{
+		TeamName: teamName,
+		From:     filter.From,
+		To:       filter.To,
+	}
+
+	// Получаем перцентили времени до мерджа
+	stats.MergeTimes, err = a.teamStatistics.GetMergeTimes(ctx, teamName, filter.From, filter.To)
+	if err != nil {
+		log.Error("Failed to get merge times",
+			slog.String("err", err.Error()),
+		)
+		return models.TeamTimingStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Распределяем открытые PR по возрасту
+	counts, err := a.teamStatistics.GetOpenPullRequestAges(
+		ctx, teamName, filter.From, filter.To, now, models.OPEN_AGE_BOUNDS,
+	)
+	if err != nil {
+		log.Error("Failed to get open PR ages",
+			slog.String("err", err.Error()),
+		)
+		return models.TeamTimingStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stats.OpenAge = make([]models.AgeBucket, len(counts))
+	for i, count := range counts {
+		stats.OpenAge[i] = models.AgeBucket{
+			Name:  models.OPEN_AGE_BUCKETS[i],
+			Count: count,
+		}
+		stats.OpenPullRequests += count
+	}
+
+	// Получаем самые старые открытые PR
+	stats.OldestOpen, err = a.teamStatistics.GetOldestOpenPullRequests(
+		ctx, teamName, filter.From, filter.To, filter.OldestLimit,
+	)
+	if err != nil {
+		log.Error("Failed to get oldest open PRs",
+			slog.String("err", err.Error()),
+		)
+		return models.TeamTimingStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got team timing stats successfully")
+
+	return stats, nil
+}
+
+// Сворачивает время до мерджа за прошедшие дни, чтобы временная
+// статистика по длинной истории не читала все PR
+func (a *PRAssignment) RollupMergeTimes(
+	ctx context.Context,
+) error {
+	const op = "service.PRAssignment.RollupMergeTimes"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+	)
+
+	log.Info("Attempting to rollup merge times")
+
+	// Текущий день ещё не закончился и не сворачивается
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	err := a.teamStatistics.RollupMergeTimes(ctx, today)
+	if err != nil {
+		log.Error("Failed to rollup merge times",
+			slog.String("err", err.Error()),
+		)
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Rolled up merge times successfully")
+
+	return nil
+}
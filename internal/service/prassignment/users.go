@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/iskanye/avito-tech-internship/internal/models"
 	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
 )
 
 // Назначает пользователю свойство is_active
@@ -18,35 +20,79 @@ func (a *PRAssignment) SetIsActive(
 ) (models.User, error) {
 	const op = "service.PRAssignment.SetIsActive"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("user_id", userID),
 	)
 
 	log.Info("Attempting to set is_active")
 
-	// Обновляем is_active пользователя
-	err := a.userModifier.SetActive(ctx, userID, isActive)
-	if err != nil {
-		log.Error("Failed to set is_active",
-			slog.String("err", err.Error()),
-		)
-		if errors.Is(err, repositories.ErrNotFound) {
-			return models.User{}, ErrNotFound
+	// Начинаем транзакцию
+	var user models.User
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пользователя, чтобы узнать прошлое значение is_active
+		var err error
+		user, err = a.userProvider.GetUser(ctx, userID)
+		if err != nil {
+			log.Error("Failed to get user",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
 		}
+		before := userSnapshot(&user)
 
-		return models.User{}, fmt.Errorf("%s: %w", op, err)
-	}
+		// Обновляем is_active пользователя
+		err = a.userModifier.SetActive(ctx, userID, isActive)
+		if err != nil {
+			log.Error("Failed to set is_active",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Публикуем событие, если пользователь был активным
+		if user.IsActive && !isActive {
+			err = a.publish(ctx, models.EVENT_USER_DEACTIVATED, models.UserEventData{
+				UserID:   user.UserID,
+				TeamName: user.TeamName,
+			})
+			if err != nil {
+				log.Error("Failed to publish event",
+					slog.String("err", err.Error()),
+				)
+
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		user.IsActive = isActive
 
-	// Получаем пользователя, чтобы вернуть
-	user, err := a.userProvider.GetUser(ctx, userID)
-	// Если на прошлом этапе уже не вылетела ошибка ErrNotFound
-	// то тут уже нет смысла её вылавливать
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_USER_SET_IS_ACTIVE,
+			UserID:    userID,
+			TeamName:  user.TeamName,
+		}, before, userSnapshot(&user))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		log.Error("Failed to get user",
-			slog.String("err", err.Error()),
-		)
-		return models.User{}, fmt.Errorf("%s: %w", op, err)
+		return models.User{}, err
 	}
 
 	log.Info("Set is_active successfully")
@@ -54,6 +100,92 @@ func (a *PRAssignment) SetIsActive(
 	return user, nil
 }
 
+// Назначает пользователю ограничение открытых ревью, nil снимает ограничение
+func (a *PRAssignment) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) (models.User, error) {
+	const op = "service.PRAssignment.SetMaxOpenReviews"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("Attempting to set max_open_reviews")
+
+	// Проверяем ограничение
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		log.Error("Invalid max open reviews")
+
+		return models.User{}, ErrInvalidMaxOpenReviews
+	}
+
+	// Начинаем транзакцию
+	var user models.User
+	err := a.inTx(ctx, func(ctx context.Context) error {
+		// Получаем пользователя до изменения
+		before, err := a.userProvider.GetUser(ctx, userID)
+		if err != nil {
+			log.Error("Failed to get user",
+				slog.String("err", err.Error()),
+			)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Обновляем max_open_reviews пользователя
+		err = a.userModifier.SetMaxOpenReviews(ctx, userID, maxOpenReviews)
+		if err != nil {
+			log.Error("Failed to set max_open_reviews",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Получаем пользователя, чтобы вернуть
+		user, err = a.userProvider.GetUser(ctx, userID)
+		if err != nil {
+			log.Error("Failed to get user",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Записываем изменение в журнал
+		err = a.audit(ctx, models.AuditEvent{
+			Operation: models.AUDIT_USER_SET_MAX_REVIEWS,
+			UserID:    userID,
+			TeamName:  user.TeamName,
+		}, userSnapshot(&before), userSnapshot(&user))
+		if err != nil {
+			log.Error("Failed to write audit event",
+				slog.String("err", err.Error()),
+			)
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	log.Info("Set max_open_reviews successfully")
+
+	return user, nil
+}
+
 // Получает пул реквесты, в которых пользователь - ревьювер
 func (a *PRAssignment) GetReview(
 	ctx context.Context,
@@ -61,7 +193,10 @@ func (a *PRAssignment) GetReview(
 ) ([]models.PullRequest, error) {
 	const op = "service.PRAssignment.GetReview"
 
-	log := a.log.With(
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
 		slog.String("op", op),
 		slog.String("user_id", userID),
 	)
@@ -85,3 +220,85 @@ func (a *PRAssignment) GetReview(
 
 	return pullRequests, nil
 }
+
+// Получает статистику пользователя. Назначения и PR учитываются
+// начиная с from (нулевое время - за всё время)
+func (a *PRAssignment) UserStats(
+	ctx context.Context,
+	userID string,
+	from time.Time,
+) (models.UserStats, error) {
+	const op = "service.PRAssignment.UserStats"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("Attempting to get user stats")
+
+	stats, err := a.userStatistics.GetUserStats(ctx, userID, from)
+	if err != nil {
+		log.Error("Failed to get user stats",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.UserStats{}, ErrNotFound
+		}
+
+		return models.UserStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got user stats successfully")
+
+	return stats, nil
+}
+
+// Получает рейтинг членов команды: сначала по ревью смердженных PR,
+// затем по всем назначениям. Назначения и PR учитываются начиная с from
+func (a *PRAssignment) TeamLeaderboard(
+	ctx context.Context,
+	teamName string,
+	from time.Time,
+) ([]models.UserStats, error) {
+	const op = "service.PRAssignment.TeamLeaderboard"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, a.log).With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	log.Info("Attempting to get team leaderboard")
+
+	// Проверяем что команда существует
+	_, err := a.teamProvider.GetTeam(ctx, teamName)
+	if err != nil {
+		log.Error("Failed to get team",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	leaderboard, err := a.userStatistics.GetTeamUserStats(ctx, teamName, from)
+	if err != nil {
+		log.Error("Failed to get team members stats",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Got team leaderboard successfully")
+
+	return leaderboard, nil
+}
@@ -0,0 +1,36 @@
+package vcssync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Клиент API системы контроля версий
+type Client interface {
+	// Запрашивает ревью у пользователей
+	RequestReviewers(
+		ctx context.Context,
+		repository string,
+		number int,
+		logins []string,
+	) error
+	// Снимает запрос ревью с пользователей
+	RemoveReviewers(
+		ctx context.Context,
+		repository string,
+		number int,
+		logins []string,
+	) error
+}
+
+// Проверяет код ответа API, добавляя в ошибку начало тела ответа
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+}
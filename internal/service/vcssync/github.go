@@ -0,0 +1,89 @@
+package vcssync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Клиент REST API GitHub
+type GitHubClient struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+func NewGitHubClient(
+	client *http.Client,
+	baseURL string,
+	token string,
+) *GitHubClient {
+	return &GitHubClient{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+// Тело запроса /requested_reviewers
+type githubReviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+func (c *GitHubClient) RequestReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+	logins []string,
+) error {
+	return c.requestedReviewers(ctx, http.MethodPost, repository, number, logins)
+}
+
+func (c *GitHubClient) RemoveReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+	logins []string,
+) error {
+	return c.requestedReviewers(ctx, http.MethodDelete, repository, number, logins)
+}
+
+// Добавляет (POST) или удаляет (DELETE) запрошенных ревьюверов PR
+func (c *GitHubClient) requestedReviewers(
+	ctx context.Context,
+	method string,
+	repository string,
+	number int,
+	logins []string,
+) error {
+	body, err := json.Marshal(githubReviewersRequest{Reviewers: logins})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers", c.baseURL, repository, number),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
@@ -0,0 +1,59 @@
+package vcssync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const token = "token"
+
+func TestGitHubClient_RequestRemoveReviewers(t *testing.T) {
+	var methods []string
+
+	// Заглушка API GitHub
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/octo-org/backend/pulls/42/requested_reviewers", r.URL.Path)
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		assert.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+
+		var body githubReviewersRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"octocat"}, body.Reviewers)
+
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(server.Client(), server.URL+"/", token)
+
+	err := client.RequestReviewers(context.Background(), "octo-org/backend", 42, []string{"octocat"})
+	require.NoError(t, err)
+	err = client.RemoveReviewers(context.Background(), "octo-org/backend", 42, []string{"octocat"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{http.MethodPost, http.MethodDelete}, methods)
+}
+
+func TestGitHubClient_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message":"Reviews may only be requested from collaborators."}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(server.Client(), server.URL, token)
+
+	err := client.RequestReviewers(context.Background(), "octo-org/backend", 42, []string{"stranger"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "422")
+	assert.Contains(t, err.Error(), "collaborators")
+}
@@ -0,0 +1,221 @@
+package vcssync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Клиент REST API GitLab. GitLab назначает ревьюверов по их числовым ID
+// и заменяет весь список сразу, поэтому клиент сначала получает текущих
+// ревьюверов merge request
+type GitLabClient struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+func NewGitLabClient(
+	client *http.Client,
+	baseURL string,
+	token string,
+) *GitLabClient {
+	return &GitLabClient{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+type gitlabUser struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type gitlabMergeRequest struct {
+	Reviewers []gitlabUser `json:"reviewers"`
+}
+
+type gitlabReviewersRequest struct {
+	ReviewerIDs []int `json:"reviewer_ids"`
+}
+
+func (c *GitLabClient) RequestReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+	logins []string,
+) error {
+	reviewers, err := c.getReviewers(ctx, repository, number)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0, len(reviewers)+len(logins))
+	for _, reviewer := range reviewers {
+		ids = append(ids, reviewer.ID)
+	}
+
+	for _, login := range logins {
+		id, err := c.getUserID(ctx, login)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return c.setReviewers(ctx, repository, number, ids)
+}
+
+func (c *GitLabClient) RemoveReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+	logins []string,
+) error {
+	reviewers, err := c.getReviewers(ctx, repository, number)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if !slices.Contains(logins, reviewer.Username) {
+			ids = append(ids, reviewer.ID)
+		}
+	}
+
+	// Снимать некого
+	if len(ids) == len(reviewers) {
+		return nil
+	}
+
+	return c.setReviewers(ctx, repository, number, ids)
+}
+
+// Получает текущих ревьюверов merge request
+func (c *GitLabClient) getReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+) ([]gitlabUser, error) {
+	var mergeRequest gitlabMergeRequest
+	err := c.do(ctx, http.MethodGet, c.mergeRequestURL(repository, number), nil, &mergeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeRequest.Reviewers, nil
+}
+
+// Заменяет ревьюверов merge request
+func (c *GitLabClient) setReviewers(
+	ctx context.Context,
+	repository string,
+	number int,
+	ids []int,
+) error {
+	return c.do(
+		ctx,
+		http.MethodPut,
+		c.mergeRequestURL(repository, number),
+		gitlabReviewersRequest{ReviewerIDs: ids},
+		nil,
+	)
+}
+
+// Получает логин пользователя по его числовому ID
+func (c *GitLabClient) GetUsername(ctx context.Context, id int) (string, error) {
+	var user gitlabUser
+	err := c.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/api/v4/users/%d", c.baseURL, id),
+		nil,
+		&user,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Username, nil
+}
+
+// Получает числовой ID пользователя по его логину
+func (c *GitLabClient) getUserID(ctx context.Context, login string) (int, error) {
+	var users []gitlabUser
+	err := c.do(
+		ctx,
+		http.MethodGet,
+		c.baseURL+"/api/v4/users?username="+url.QueryEscape(login),
+		nil,
+		&users,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("gitlab user %q not found", login)
+	}
+
+	return users[0].ID, nil
+}
+
+func (c *GitLabClient) mergeRequestURL(repository string, number int) string {
+	return fmt.Sprintf(
+		"%s/api/v4/projects/%s/merge_requests/%d",
+		c.baseURL, url.PathEscape(repository), number,
+	)
+}
+
+// Выполняет запрос к API, кодируя in и декодируя ответ в out
+func (c *GitLabClient) do(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	in any,
+	out any,
+) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = checkResponse(resp)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,128 @@
+package vcssync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mergeRequestPath = "/api/v4/projects/gitlabhq%2Fgitlab-test/merge_requests/1"
+
+// Заглушка API GitLab с одним merge request
+type gitlabStub struct {
+	users     map[string]int
+	reviewers []gitlabUser
+	puts      int
+}
+
+func (s *gitlabStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("PRIVATE-TOKEN") != token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/api/v4/users/"):
+		for username, id := range s.users {
+			if r.URL.Path == fmt.Sprintf("/api/v4/users/%d", id) {
+				json.NewEncoder(w).Encode(gitlabUser{ID: id, Username: username})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.URL.Path == "/api/v4/users":
+		users := []gitlabUser{}
+		username := r.URL.Query().Get("username")
+		if id, ok := s.users[username]; ok {
+			users = append(users, gitlabUser{ID: id, Username: username})
+		}
+		json.NewEncoder(w).Encode(users)
+	case r.URL.EscapedPath() == mergeRequestPath && r.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(gitlabMergeRequest{Reviewers: s.reviewers})
+	case r.URL.EscapedPath() == mergeRequestPath && r.Method == http.MethodPut:
+		var body gitlabReviewersRequest
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.puts++
+		s.reviewers = []gitlabUser{}
+		for _, id := range body.ReviewerIDs {
+			for username, userID := range s.users {
+				if userID == id {
+					s.reviewers = append(s.reviewers, gitlabUser{ID: id, Username: username})
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(gitlabMergeRequest{Reviewers: s.reviewers})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestGitLabClient_RequestRemoveReviewers(t *testing.T) {
+	stub := &gitlabStub{
+		users:     map[string]int{"root": 1, "alice": 2, "bob": 3},
+		reviewers: []gitlabUser{{ID: 1, Username: "root"}},
+	}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	client := NewGitLabClient(server.Client(), server.URL, token)
+	ctx := context.Background()
+
+	// Новые ревьюверы добавляются к существующим
+	err := client.RequestReviewers(ctx, "gitlabhq/gitlab-test", 1, []string{"alice", "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, []gitlabUser{
+		{ID: 1, Username: "root"},
+		{ID: 2, Username: "alice"},
+		{ID: 3, Username: "bob"},
+	}, stub.reviewers)
+
+	// Снимается только указанный ревьювер
+	err = client.RemoveReviewers(ctx, "gitlabhq/gitlab-test", 1, []string{"alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []gitlabUser{
+		{ID: 1, Username: "root"},
+		{ID: 3, Username: "bob"},
+	}, stub.reviewers)
+
+	// Снимать некого - список не изменяется
+	err = client.RemoveReviewers(ctx, "gitlabhq/gitlab-test", 1, []string{"alice"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, stub.puts)
+}
+
+func TestGitLabClient_UnknownUser(t *testing.T) {
+	stub := &gitlabStub{users: map[string]int{}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	client := NewGitLabClient(server.Client(), server.URL, token)
+
+	err := client.RequestReviewers(context.Background(), "gitlabhq/gitlab-test", 1, []string{"ghost"})
+	require.Error(t, err)
+	assert.Equal(t, 0, stub.puts)
+}
+
+func TestGitLabClient_GetUsername(t *testing.T) {
+	stub := &gitlabStub{users: map[string]int{"root": 1, "alice": 2}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	client := NewGitLabClient(server.Client(), server.URL, token)
+	ctx := context.Background()
+
+	username, err := client.GetUsername(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", username)
+
+	_, err = client.GetUsername(ctx, 404)
+	require.Error(t, err)
+}
@@ -0,0 +1,315 @@
+package vcssync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+)
+
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/service/vcssync")
+
+// Переносит назначения ревьюверов в системы контроля версий.
+// События о назначении превращаются в задачи в той же транзакции,
+// а задачи выполняются в фоне с повторными попытками
+type Syncer struct {
+	log     *slog.Logger
+	queue   JobQueue
+	logins  LoginProvider
+	clients map[models.VCSProvider]Client
+
+	// Количество задач, забираемых за раз
+	batchSize int
+	// Количество задач, выполняемых одновременно
+	parallelism int
+	// Время, на которое задача блокируется для выполнения. Не меньше
+	// времени, за которое порция гарантированно успевает выполниться
+	lease time.Duration
+
+	// Количество попыток, после которого задача прекращается
+	maxAttempts int
+	// Задержка перед повторной попыткой удваивается
+	// с каждой неудачей, начиная с backoffBase
+	backoffBase time.Duration
+	backoffMax  time.Duration
+}
+
+// Очередь задач синхронизации
+type JobQueue interface {
+	EnqueueVCSSyncJob(
+		ctx context.Context,
+		job models.VCSSyncJob,
+		nextAttemptAt time.Time,
+	) error
+	ClaimVCSSyncJobs(
+		ctx context.Context,
+		now time.Time,
+		lockedUntil time.Time,
+		limit int,
+	) ([]models.VCSSyncJob, error)
+	CompleteVCSSyncJob(
+		ctx context.Context,
+		jobID int64,
+		doneAt time.Time,
+	) error
+	RetryVCSSyncJob(
+		ctx context.Context,
+		jobID int64,
+		nextAttemptAt time.Time,
+		lastError string,
+	) error
+	FailVCSSyncJob(
+		ctx context.Context,
+		jobID int64,
+		failedAt time.Time,
+		lastError string,
+	) error
+}
+
+type LoginProvider interface {
+	GetVCSLoginsByUserIDs(
+		ctx context.Context,
+		provider models.VCSProvider,
+		userIDs []string,
+	) ([]string, error)
+}
+
+// clients содержит клиентов только настроенных систем,
+// PR остальных систем не синхронизируются. timeout - тайм-аут
+// одного запроса клиента к API
+func New(
+	log *slog.Logger,
+	queue JobQueue,
+	logins LoginProvider,
+	clients map[models.VCSProvider]Client,
+	timeout time.Duration,
+	batchSize int,
+	parallelism int,
+	lease time.Duration,
+	maxAttempts int,
+	backoffBase time.Duration,
+	backoffMax time.Duration,
+) *Syncer {
+	parallelism = max(parallelism, 1)
+
+	// Задачи выполняются волнами по parallelism штук, и каждая делает
+	// не больше двух запросов к API. Если аренда короче, другой
+	// экземпляр заберёт ещё не выполненные задачи повторно
+	rounds := (batchSize + parallelism - 1) / parallelism
+	lease = max(lease, time.Duration(rounds)*2*timeout)
+
+	return &Syncer{
+		log:         log,
+		queue:       queue,
+		logins:      logins,
+		clients:     clients,
+		batchSize:   batchSize,
+		parallelism: parallelism,
+		lease:       lease,
+		maxAttempts: maxAttempts,
+		backoffBase: backoffBase,
+		backoffMax:  backoffMax,
+	}
+}
+
+// Ставит в очередь задачу по событию о назначении или замене ревьювера.
+// Остальные события и PR, созданные не из системы контроля версий,
+// пропускаются
+func (s *Syncer) PublishEvent(
+	ctx context.Context,
+	event models.Event,
+) error {
+	const op = "service.Syncer.PublishEvent"
+
+	if event.Type != models.EVENT_REVIEWER_ASSIGNED &&
+		event.Type != models.EVENT_REVIEWER_REASSIGNED {
+		return nil
+	}
+	data, ok := event.Data.(models.ReviewerEventData)
+	if !ok {
+		return nil
+	}
+
+	provider, repository, number, ok := models.ParseVCSPullRequestID(data.PullRequestID)
+	if !ok {
+		return nil
+	}
+	if _, ok := s.clients[provider]; !ok {
+		return nil
+	}
+
+	// Пользователи без логина в этой системе пропускаются
+	request, err := s.logins.GetVCSLoginsByUserIDs(ctx, provider, []string{data.ReviewerID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	remove := []string{}
+	if data.OldReviewerID != "" {
+		remove, err = s.logins.GetVCSLoginsByUserIDs(ctx, provider, []string{data.OldReviewerID})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if len(request) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	err = s.queue.EnqueueVCSSyncJob(ctx, models.VCSSyncJob{
+		Provider:      provider,
+		Repository:    repository,
+		Number:        number,
+		RequestLogins: request,
+		RemoveLogins:  remove,
+	}, event.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Выполняет задачи с заданным интервалом, пока не отменён контекст
+func (s *Syncer) Run(ctx context.Context, interval time.Duration) {
+	const op = "service.Syncer.Run"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("VCS syncer stopped")
+			return
+		case <-ticker.C:
+			// Выполняем, пока очередь не опустеет
+			for {
+				done, err := s.Sync(ctx)
+				if err != nil {
+					log.Error("Failed to sync reviewers",
+						slog.String("err", err.Error()),
+					)
+				}
+				if err != nil || done < s.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Забирает и выполняет одну порцию задач.
+// Возвращает количество обработанных задач
+func (s *Syncer) Sync(ctx context.Context) (int, error) {
+	const op = "service.Syncer.Sync"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	now := time.Now()
+	jobs, err := s.queue.ClaimVCSSyncJobs(ctx, now, now.Add(s.lease), s.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Выполняем параллельно, чтобы порция успела выполниться за время
+	// аренды. Ошибка одной задачи не прерывает остальные
+	var group errgroup.Group
+	group.SetLimit(s.parallelism)
+	for _, job := range jobs {
+		group.Go(func() error {
+			err := s.process(ctx, job)
+			if err != nil {
+				tracing.Logger(ctx, s.log).Error("Failed to save VCS sync result",
+					slog.String("op", op),
+					slog.Int64("job_id", job.ID),
+					slog.String("err", err.Error()),
+				)
+			}
+
+			return err
+		})
+	}
+	err = group.Wait()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(jobs), nil
+}
+
+// Выполняет задачу и сохраняет результат попытки
+func (s *Syncer) process(ctx context.Context, job models.VCSSyncJob) error {
+	log := tracing.Logger(ctx, s.log).With(
+		slog.Int64("job_id", job.ID),
+		slog.String("provider", job.Provider),
+		slog.String("repository", job.Repository),
+		slog.Int("number", job.Number),
+	)
+
+	syncErr := s.sync(ctx, job)
+	now := time.Now()
+	if syncErr == nil {
+		return s.queue.CompleteVCSSyncJob(ctx, job.ID, now)
+	}
+
+	// Попытки закончились
+	attempts := job.Attempts + 1
+	if attempts >= s.maxAttempts {
+		log.Error("VCS sync failed",
+			slog.Int("attempts", attempts),
+			slog.String("err", syncErr.Error()),
+		)
+
+		return s.queue.FailVCSSyncJob(ctx, job.ID, now, syncErr.Error())
+	}
+
+	log.Warn("VCS sync attempt failed",
+		slog.Int("attempts", attempts),
+		slog.String("err", syncErr.Error()),
+	)
+
+	return s.queue.RetryVCSSyncJob(ctx, job.ID, now.Add(s.backoff(attempts)), syncErr.Error())
+}
+
+// Запрашивает ревью у новых ревьюверов, затем снимает старых.
+// Оба вызова идемпотентны, поэтому задачу можно повторять целиком
+func (s *Syncer) sync(ctx context.Context, job models.VCSSyncJob) error {
+	client, ok := s.clients[job.Provider]
+	if !ok {
+		return fmt.Errorf("VCS provider %q is not configured", job.Provider)
+	}
+
+	if len(job.RequestLogins) > 0 {
+		err := client.RequestReviewers(ctx, job.Repository, job.Number, job.RequestLogins)
+		if err != nil {
+			return fmt.Errorf("request reviewers: %w", err)
+		}
+	}
+	if len(job.RemoveLogins) > 0 {
+		err := client.RemoveReviewers(ctx, job.Repository, job.Number, job.RemoveLogins)
+		if err != nil {
+			return fmt.Errorf("remove reviewers: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Задержка перед следующей попыткой после attempts неудачных
+func (s *Syncer) backoff(attempts int) time.Duration {
+	delay := s.backoffBase
+	for i := 1; i < attempts && delay < s.backoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.backoffMax)
+}
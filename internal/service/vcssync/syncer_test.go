@@ -0,0 +1,275 @@
+package vcssync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	maxAttempts = 3
+	backoffBase = time.Second
+	backoffMax  = 3 * time.Second
+)
+
+// Очередь задач в памяти
+type queue struct {
+	mu sync.Mutex
+
+	pending     []models.VCSSyncJob
+	lockedUntil time.Time
+	done        []int64
+	failed      []int64
+	retries     map[int64]time.Time
+	// Задачи, результат которых не сохраняется
+	broken map[int64]bool
+}
+
+func (q *queue) EnqueueVCSSyncJob(_ context.Context, job models.VCSSyncJob, _ time.Time) error {
+	job.ID = int64(len(q.pending) + 1)
+	q.pending = append(q.pending, job)
+	return nil
+}
+
+func (q *queue) ClaimVCSSyncJobs(
+	_ context.Context,
+	_ time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.VCSSyncJob, error) {
+	q.lockedUntil = lockedUntil
+	n := min(limit, len(q.pending))
+	claimed := q.pending[:n]
+	q.pending = q.pending[n:]
+	return claimed, nil
+}
+
+func (q *queue) CompleteVCSSyncJob(_ context.Context, id int64, _ time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.broken[id] {
+		return errors.New("database is unavailable")
+	}
+	q.done = append(q.done, id)
+	return nil
+}
+
+func (q *queue) RetryVCSSyncJob(_ context.Context, id int64, next time.Time, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.retries[id] = next
+	return nil
+}
+
+func (q *queue) FailVCSSyncJob(_ context.Context, id int64, _ time.Time, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.failed = append(q.failed, id)
+	return nil
+}
+
+// Соответствие пользователей логинам GitHub
+type logins map[string]string
+
+func (l logins) GetVCSLoginsByUserIDs(
+	_ context.Context,
+	provider models.VCSProvider,
+	userIDs []string,
+) ([]string, error) {
+	res := []string{}
+	for _, userID := range userIDs {
+		if login, ok := l[userID]; ok && provider == models.VCS_GITHUB {
+			res = append(res, login)
+		}
+	}
+	return res, nil
+}
+
+// Клиент, запоминающий вызовы
+type client struct {
+	mu sync.Mutex
+
+	err       error
+	requested []string
+	removed   []string
+}
+
+func (c *client) RequestReviewers(_ context.Context, _ string, _ int, logins []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.requested = append(c.requested, logins...)
+	return nil
+}
+
+func (c *client) RemoveReviewers(_ context.Context, _ string, _ int, logins []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removed = append(c.removed, logins...)
+	return nil
+}
+
+func newSyncer(q *queue, c *client) *Syncer {
+	return New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		q,
+		logins{"u1": "alice", "u2": "bob"},
+		map[models.VCSProvider]Client{models.VCS_GITHUB: c},
+		time.Second,
+		10,
+		2,
+		time.Minute,
+		maxAttempts,
+		backoffBase,
+		backoffMax,
+	)
+}
+
+func reviewerEvent(eventType models.EventType, data models.ReviewerEventData) models.Event {
+	return models.Event{Type: eventType, OccurredAt: time.Now(), Data: data}
+}
+
+func TestSyncer_PublishEvent(t *testing.T) {
+	q := &queue{retries: map[int64]time.Time{}}
+	s := newSyncer(q, &client{})
+	ctx := context.Background()
+
+	// Назначение в PR из GitHub
+	err := s.PublishEvent(ctx, reviewerEvent(models.EVENT_REVIEWER_ASSIGNED, models.ReviewerEventData{
+		PullRequestID: "octo-org/backend#42",
+		ReviewerID:    "u1",
+	}))
+	require.NoError(t, err)
+
+	// Замена ревьювера
+	err = s.PublishEvent(ctx, reviewerEvent(models.EVENT_REVIEWER_REASSIGNED, models.ReviewerEventData{
+		PullRequestID: "octo-org/backend#42",
+		ReviewerID:    "u2",
+		OldReviewerID: "u1",
+	}))
+	require.NoError(t, err)
+
+	// Пропускаются: PR не из системы контроля версий, ненастроенная система,
+	// пользователь без логина и другие события
+	skipped := []models.Event{
+		reviewerEvent(models.EVENT_REVIEWER_ASSIGNED, models.ReviewerEventData{PullRequestID: "pr-1", ReviewerID: "u1"}),
+		reviewerEvent(models.EVENT_REVIEWER_ASSIGNED, models.ReviewerEventData{PullRequestID: "group/project!1", ReviewerID: "u1"}),
+		reviewerEvent(models.EVENT_REVIEWER_ASSIGNED, models.ReviewerEventData{PullRequestID: "octo-org/backend#42", ReviewerID: "u3"}),
+		{Type: models.EVENT_PR_CREATED, Data: models.PullRequestEventData{PullRequestID: "octo-org/backend#42"}},
+	}
+	for _, event := range skipped {
+		require.NoError(t, s.PublishEvent(ctx, event))
+	}
+
+	require.Len(t, q.pending, 2)
+	assert.Equal(t, models.VCSSyncJob{
+		ID:            1,
+		Provider:      models.VCS_GITHUB,
+		Repository:    "octo-org/backend",
+		Number:        42,
+		RequestLogins: []string{"alice"},
+		RemoveLogins:  []string{},
+	}, q.pending[0])
+	assert.Equal(t, []string{"bob"}, q.pending[1].RequestLogins)
+	assert.Equal(t, []string{"alice"}, q.pending[1].RemoveLogins)
+}
+
+func TestSyncer_Sync_Success(t *testing.T) {
+	q := &queue{
+		pending: []models.VCSSyncJob{{
+			ID:            1,
+			Provider:      models.VCS_GITHUB,
+			Repository:    "octo-org/backend",
+			Number:        42,
+			RequestLogins: []string{"bob"},
+			RemoveLogins:  []string{"alice"},
+		}},
+		retries: map[int64]time.Time{},
+	}
+	c := &client{}
+
+	done, err := newSyncer(q, c).Sync(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, done)
+	assert.Equal(t, []int64{1}, q.done)
+	assert.Equal(t, []string{"bob"}, c.requested)
+	assert.Equal(t, []string{"alice"}, c.removed)
+}
+
+func TestSyncer_Sync_Retry(t *testing.T) {
+	q := &queue{
+		pending: []models.VCSSyncJob{
+			{ID: 1, Provider: models.VCS_GITHUB, RequestLogins: []string{"bob"}},
+			{ID: 2, Provider: models.VCS_GITHUB, RequestLogins: []string{"bob"}, Attempts: maxAttempts - 1},
+			{ID: 3, Provider: models.VCS_GITLAB, RequestLogins: []string{"bob"}, Attempts: maxAttempts - 1},
+		},
+		retries: map[int64]time.Time{},
+	}
+	c := &client{err: errors.New("unexpected status code 502")}
+
+	before := time.Now()
+	done, err := newSyncer(q, c).Sync(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, done)
+	assert.Empty(t, q.done)
+
+	// Первая задача откладывается, у остальных закончились попытки
+	require.Contains(t, q.retries, int64(1))
+	assert.WithinDuration(t, before.Add(backoffBase), q.retries[1], time.Second)
+	assert.ElementsMatch(t, []int64{2, 3}, q.failed)
+	// Снимать старых ревьюверов не пытаемся, пока не запрошены новые
+	assert.Empty(t, c.removed)
+}
+
+func TestSyncer_Sync_ContinuesAfterError(t *testing.T) {
+	q := &queue{
+		pending: []models.VCSSyncJob{
+			{ID: 1, Provider: models.VCS_GITHUB, RequestLogins: []string{"alice"}},
+			{ID: 2, Provider: models.VCS_GITHUB, RequestLogins: []string{"bob"}},
+			{ID: 3, Provider: models.VCS_GITHUB, RequestLogins: []string{"carol"}},
+		},
+		retries: map[int64]time.Time{},
+		broken:  map[int64]bool{1: true},
+	}
+	c := &client{}
+
+	// Результат первой задачи не сохранился, но остальные выполняются
+	_, err := newSyncer(q, c).Sync(context.Background())
+	require.Error(t, err)
+	assert.ElementsMatch(t, []int64{2, 3}, q.done)
+	assert.ElementsMatch(t, []string{"alice", "bob", "carol"}, c.requested)
+}
+
+func TestSyncer_Lease(t *testing.T) {
+	q := &queue{retries: map[int64]time.Time{}}
+
+	// 20 задач по 5 одновременно выполняются в 4 волны по два запроса
+	s := New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		q,
+		logins{},
+		map[models.VCSProvider]Client{},
+		10*time.Second,
+		20,
+		5,
+		time.Minute,
+		maxAttempts,
+		backoffBase,
+		backoffMax,
+	)
+
+	before := time.Now()
+	_, err := s.Sync(context.Background())
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(80*time.Second), q.lockedUntil, time.Second)
+}
@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"golang.org/x/sync/errgroup"
+)
+
+// Заголовки запроса с событием
+const (
+	EVENT_HEADER     = "X-Webhook-Event"
+	DELIVERY_HEADER  = "X-Webhook-Delivery"
+	SIGNATURE_HEADER = "X-Webhook-Signature"
+)
+
+// Отправляет события из исходящей очереди подписчикам
+type Dispatcher struct {
+	log    *slog.Logger
+	client *http.Client
+	queue  DeliveryQueue
+
+	// Количество доставок, забираемых за раз
+	batchSize int
+	// Количество доставок, отправляемых одновременно
+	parallelism int
+	// Время, на которое доставка блокируется для отправки. Не меньше
+	// времени, за которое порция гарантированно успевает отправиться
+	lease time.Duration
+
+	// Количество попыток, после которого доставка прекращается
+	maxAttempts int
+	// Задержка перед повторной попыткой удваивается
+	// с каждой неудачей, начиная с backoffBase
+	backoffBase time.Duration
+	backoffMax  time.Duration
+}
+
+// Исходящая очередь событий
+type DeliveryQueue interface {
+	ClaimDeliveries(
+		ctx context.Context,
+		now time.Time,
+		lockedUntil time.Time,
+		limit int,
+	) ([]models.WebhookDelivery, error)
+	MarkDelivered(
+		ctx context.Context,
+		deliveryID int64,
+		deliveredAt time.Time,
+	) error
+	RetryDelivery(
+		ctx context.Context,
+		deliveryID int64,
+		nextAttemptAt time.Time,
+		lastError string,
+	) error
+	FailDelivery(
+		ctx context.Context,
+		deliveryID int64,
+		failedAt time.Time,
+		lastError string,
+	) error
+}
+
+func NewDispatcher(
+	log *slog.Logger,
+	client *http.Client,
+	queue DeliveryQueue,
+	batchSize int,
+	parallelism int,
+	lease time.Duration,
+	maxAttempts int,
+	backoffBase time.Duration,
+	backoffMax time.Duration,
+) *Dispatcher {
+	parallelism = max(parallelism, 1)
+
+	// Доставки отправляются волнами по parallelism штук, и каждая
+	// отправка длится не дольше тайм-аута клиента. Если аренда короче,
+	// другой экземпляр заберёт ещё не отправленные доставки повторно
+	rounds := (batchSize + parallelism - 1) / parallelism
+	lease = max(lease, time.Duration(rounds)*client.Timeout)
+
+	return &Dispatcher{
+		log:         log,
+		client:      client,
+		queue:       queue,
+		batchSize:   batchSize,
+		parallelism: parallelism,
+		lease:       lease,
+		maxAttempts: maxAttempts,
+		backoffBase: backoffBase,
+		backoffMax:  backoffMax,
+	}
+}
+
+// Отправляет события с заданным интервалом, пока не отменён контекст
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	const op = "service.Dispatcher.Run"
+
+	log := d.log.With(
+		slog.String("op", op),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Dispatcher stopped")
+			return
+		case <-ticker.C:
+			// Отправляем, пока очередь не опустеет
+			for {
+				sent, err := d.Dispatch(ctx)
+				if err != nil {
+					log.Error("Failed to dispatch webhooks",
+						slog.String("err", err.Error()),
+					)
+				}
+				if err != nil || sent < d.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Забирает и отправляет одну порцию событий.
+// Возвращает количество обработанных доставок
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	const op = "service.Dispatcher.Dispatch"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	now := time.Now()
+	deliveries, err := d.queue.ClaimDeliveries(ctx, now, now.Add(d.lease), d.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Отправляем параллельно, чтобы порция успела отправиться за время
+	// аренды. Ошибка одной доставки не прерывает остальные
+	var group errgroup.Group
+	group.SetLimit(d.parallelism)
+	for _, delivery := range deliveries {
+		group.Go(func() error {
+			return d.deliver(ctx, delivery)
+		})
+	}
+	err = group.Wait()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(deliveries), nil
+}
+
+// Отправляет событие и сохраняет результат попытки
+func (d *Dispatcher) deliver(ctx context.Context, delivery models.WebhookDelivery) error {
+	log := tracing.Logger(ctx, d.log).With(
+		slog.Int64("delivery_id", delivery.ID),
+		slog.String("event", delivery.Event),
+	)
+
+	sendErr := d.send(ctx, delivery)
+	now := time.Now()
+	if sendErr == nil {
+		return d.queue.MarkDelivered(ctx, delivery.ID, now)
+	}
+
+	// Попытки закончились
+	attempts := delivery.Attempts + 1
+	if attempts >= d.maxAttempts {
+		log.Error("Webhook delivery failed",
+			slog.Int("attempts", attempts),
+			slog.String("err", sendErr.Error()),
+		)
+
+		return d.queue.FailDelivery(ctx, delivery.ID, now, sendErr.Error())
+	}
+
+	log.Warn("Webhook delivery attempt failed",
+		slog.Int("attempts", attempts),
+		slog.String("err", sendErr.Error()),
+	)
+
+	return d.queue.RetryDelivery(ctx, delivery.ID, now.Add(d.backoff(attempts)), sendErr.Error())
+}
+
+// Отправляет подписанное событие подписчику
+func (d *Dispatcher) send(ctx context.Context, delivery models.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		delivery.URL,
+		bytes.NewReader(delivery.Payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EVENT_HEADER, delivery.Event)
+	req.Header.Set(DELIVERY_HEADER, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(SIGNATURE_HEADER, Sign(delivery.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Задержка перед следующей попыткой после attempts неудачных
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.backoffBase
+	for i := 1; i < attempts && delay < d.backoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.backoffMax)
+}
+
+// Подписывает тело запроса секретом подписки (HMAC-SHA256)
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,210 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret      = "secret"
+	maxAttempts = 3
+	backoffBase = time.Second
+	backoffMax  = 3 * time.Second
+)
+
+// Исходящая очередь в памяти
+type queue struct {
+	mu sync.Mutex
+
+	pending     []models.WebhookDelivery
+	lockedUntil time.Time
+	delivered   []int64
+	failed      []int64
+	retries     map[int64]time.Time
+}
+
+func (q *queue) ClaimDeliveries(
+	_ context.Context,
+	_ time.Time,
+	lockedUntil time.Time,
+	limit int,
+) ([]models.WebhookDelivery, error) {
+	q.lockedUntil = lockedUntil
+	n := min(limit, len(q.pending))
+	claimed := q.pending[:n]
+	q.pending = q.pending[n:]
+	return claimed, nil
+}
+
+func (q *queue) MarkDelivered(_ context.Context, id int64, _ time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.delivered = append(q.delivered, id)
+	return nil
+}
+
+func (q *queue) RetryDelivery(_ context.Context, id int64, next time.Time, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.retries[id] = next
+	return nil
+}
+
+func (q *queue) FailDelivery(_ context.Context, id int64, _ time.Time, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.failed = append(q.failed, id)
+	return nil
+}
+
+func newDispatcher(q *queue) *Dispatcher {
+	return NewDispatcher(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		http.DefaultClient,
+		q,
+		10,
+		2,
+		time.Minute,
+		maxAttempts,
+		backoffBase,
+		backoffMax,
+	)
+}
+
+func TestDispatcher_Dispatch_Success(t *testing.T) {
+	payload := []byte(`{"event":"pull_request.created"}`)
+
+	// Подписчик проверяет подпись и заголовки
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, payload, body)
+		assert.Equal(t, Sign(secret, body), r.Header.Get(SIGNATURE_HEADER))
+		assert.Equal(t, models.EVENT_PR_CREATED, r.Header.Get(EVENT_HEADER))
+		assert.Equal(t, "1", r.Header.Get(DELIVERY_HEADER))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	q := &queue{
+		pending: []models.WebhookDelivery{{
+			ID:      1,
+			Event:   models.EVENT_PR_CREATED,
+			Payload: payload,
+			URL:     server.URL,
+			Secret:  secret,
+		}},
+		retries: map[int64]time.Time{},
+	}
+
+	sent, err := newDispatcher(q).Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []int64{1}, q.delivered)
+	assert.Empty(t, q.retries)
+	assert.Empty(t, q.failed)
+}
+
+func TestDispatcher_Dispatch_Retry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	q := &queue{
+		pending: []models.WebhookDelivery{
+			{ID: 1, URL: server.URL, Secret: secret, Attempts: 0},
+			{ID: 2, URL: server.URL, Secret: secret, Attempts: maxAttempts - 1},
+		},
+		retries: map[int64]time.Time{},
+	}
+
+	before := time.Now()
+	sent, err := newDispatcher(q).Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Empty(t, q.delivered)
+
+	// Первая доставка откладывается, у второй закончились попытки
+	require.Contains(t, q.retries, int64(1))
+	assert.WithinDuration(t, before.Add(backoffBase), q.retries[1], time.Second)
+	assert.Equal(t, []int64{2}, q.failed)
+}
+
+func TestDispatcher_Dispatch_Parallel(t *testing.T) {
+	// Подписчик принимает событие, только если все запросы порции
+	// пришли одновременно
+	var received sync.WaitGroup
+	received.Add(2)
+	all := make(chan struct{})
+	go func() {
+		received.Wait()
+		close(all)
+	}()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Done()
+		select {
+		case <-all:
+			w.WriteHeader(http.StatusNoContent)
+		case <-time.After(time.Second):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	q := &queue{
+		pending: []models.WebhookDelivery{
+			{ID: 1, URL: server.URL, Secret: secret},
+			{ID: 2, URL: server.URL, Secret: secret},
+		},
+		retries: map[int64]time.Time{},
+	}
+
+	sent, err := newDispatcher(q).Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.ElementsMatch(t, []int64{1, 2}, q.delivered)
+}
+
+func TestDispatcher_Lease(t *testing.T) {
+	q := &queue{retries: map[int64]time.Time{}}
+
+	// 10 доставок по 3 одновременно отправляются в 4 волны по 5 секунд
+	d := NewDispatcher(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		&http.Client{Timeout: 5 * time.Second},
+		q,
+		10,
+		3,
+		time.Second,
+		maxAttempts,
+		backoffBase,
+		backoffMax,
+	)
+
+	before := time.Now()
+	_, err := d.Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(20*time.Second), q.lockedUntil, time.Second)
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	d := newDispatcher(&queue{})
+
+	assert.Equal(t, backoffBase, d.backoff(1))
+	assert.Equal(t, 2*backoffBase, d.backoff(2))
+	assert.Equal(t, backoffMax, d.backoff(3))
+	assert.Equal(t, backoffMax, d.backoff(10))
+}
@@ -0,0 +1,10 @@
+package webhooks
+
+import "errors"
+
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrInvalidURL   = errors.New("webhook url must be an absolute http(s) URL")
+	ErrEmptySecret  = errors.New("webhook secret must not be empty")
+	ErrUnknownEvent = errors.New("unknown webhook event")
+)
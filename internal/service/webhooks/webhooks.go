@@ -0,0 +1,281 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/iskanye/avito-tech-internship/internal/models"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/service/webhooks")
+
+type Webhooks struct {
+	log *slog.Logger
+
+	// Объекты для взаимодействия с подписками
+	webhookCreator  WebhookCreator
+	webhookProvider WebhookProvider
+	webhookModifier WebhookModifier
+}
+
+// Интерфейсы для работы сервиса
+
+type WebhookCreator interface {
+	AddWebhook(
+		ctx context.Context,
+		webhook models.Webhook,
+	) (int64, error)
+}
+
+type WebhookProvider interface {
+	GetWebhook(
+		ctx context.Context,
+		webhookID int64,
+	) (models.Webhook, error)
+	ListWebhooks(
+		ctx context.Context,
+	) ([]models.Webhook, error)
+}
+
+type WebhookModifier interface {
+	UpdateWebhook(
+		ctx context.Context,
+		webhook models.Webhook,
+	) error
+	DeleteWebhook(
+		ctx context.Context,
+		webhookID int64,
+	) error
+}
+
+func New(
+	log *slog.Logger,
+
+	webhookCreator WebhookCreator,
+	webhookProvider WebhookProvider,
+	webhookModifier WebhookModifier,
+) *Webhooks {
+	return &Webhooks{
+		log: log,
+
+		webhookCreator:  webhookCreator,
+		webhookProvider: webhookProvider,
+		webhookModifier: webhookModifier,
+	}
+}
+
+// Создаёт подписку на события
+func (w *Webhooks) AddWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) (models.Webhook, error) {
+	const op = "service.Webhooks.AddWebhook"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, w.log).With(
+		slog.String("op", op),
+		slog.String("url", webhook.URL),
+	)
+
+	log.Info("Attempting to add webhook")
+
+	if webhook.Events == nil {
+		webhook.Events = []models.EventType{}
+	}
+
+	// Проверяем подписку
+	err := validateWebhook(&webhook)
+	if err != nil {
+		log.Error("Invalid webhook",
+			slog.String("err", err.Error()),
+		)
+
+		return models.Webhook{}, err
+	}
+
+	webhook.CreatedAt = time.Now().Truncate(time.Second)
+
+	// Сохраняем подписку
+	id, err := w.webhookCreator.AddWebhook(ctx, webhook)
+	if err != nil {
+		log.Error("Failed to add webhook",
+			slog.String("err", err.Error()),
+		)
+
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, err)
+	}
+	webhook.ID = id
+
+	log.Info("Added webhook successfully")
+
+	return webhook, nil
+}
+
+// Получает все подписки
+func (w *Webhooks) ListWebhooks(
+	ctx context.Context,
+) ([]models.Webhook, error) {
+	const op = "service.Webhooks.ListWebhooks"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, w.log).With(
+		slog.String("op", op),
+	)
+
+	log.Info("Attempting to list webhooks")
+
+	webhooks, err := w.webhookProvider.ListWebhooks(ctx)
+	if err != nil {
+		log.Error("Failed to list webhooks",
+			slog.String("err", err.Error()),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Listed webhooks successfully")
+
+	return webhooks, nil
+}
+
+// Изменяет подписку
+func (w *Webhooks) UpdateWebhook(
+	ctx context.Context,
+	webhookID int64,
+	update models.WebhookUpdate,
+) (models.Webhook, error) {
+	const op = "service.Webhooks.UpdateWebhook"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, w.log).With(
+		slog.String("op", op),
+		slog.Int64("webhook_id", webhookID),
+	)
+
+	log.Info("Attempting to update webhook")
+
+	// Получаем подписку
+	webhook, err := w.webhookProvider.GetWebhook(ctx, webhookID)
+	if err != nil {
+		log.Error("Failed to get webhook",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.Webhook{}, ErrNotFound
+		}
+
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Применяем изменения
+	if update.URL != nil {
+		webhook.URL = *update.URL
+	}
+	if update.Secret != nil {
+		webhook.Secret = *update.Secret
+	}
+	if update.Events != nil {
+		webhook.Events = *update.Events
+	}
+	if update.IsActive != nil {
+		webhook.IsActive = *update.IsActive
+	}
+	if webhook.Events == nil {
+		webhook.Events = []models.EventType{}
+	}
+
+	// Проверяем подписку
+	err = validateWebhook(&webhook)
+	if err != nil {
+		log.Error("Invalid webhook",
+			slog.String("err", err.Error()),
+		)
+
+		return models.Webhook{}, err
+	}
+
+	// Сохраняем подписку
+	err = w.webhookModifier.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		log.Error("Failed to update webhook",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.Webhook{}, ErrNotFound
+		}
+
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Updated webhook successfully")
+
+	return webhook, nil
+}
+
+// Удаляет подписку
+func (w *Webhooks) DeleteWebhook(
+	ctx context.Context,
+	webhookID int64,
+) error {
+	const op = "service.Webhooks.DeleteWebhook"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, w.log).With(
+		slog.String("op", op),
+		slog.Int64("webhook_id", webhookID),
+	)
+
+	log.Info("Attempting to delete webhook")
+
+	err := w.webhookModifier.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		log.Error("Failed to delete webhook",
+			slog.String("err", err.Error()),
+		)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Deleted webhook successfully")
+
+	return nil
+}
+
+// Проверяет адрес, секрет и события подписки
+func validateWebhook(webhook *models.Webhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+
+	if webhook.Secret == "" {
+		return ErrEmptySecret
+	}
+
+	for _, event := range webhook.Events {
+		if !slices.Contains(models.EventTypes, event) {
+			return ErrUnknownEvent
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Добавляет к логгеру ID трейса и спана из контекста,
+// чтобы записи лога можно было найти по трейсу
+func Logger(ctx context.Context, log *slog.Logger) *slog.Logger {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return log
+	}
+
+	return log.With(
+		slog.String("trace_id", spanCtx.TraceID().String()),
+		slog.String("span_id", spanCtx.SpanID().String()),
+	)
+}
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/iskanye/avito-tech-internship/pkg/api"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Создаёт спан обработчика, названный по ID операции OpenAPI.
+// Спан кладётся в контекст запроса, откуда его берут нижние слои
+func StrictMiddleware() api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx *gin.Context, request any) (any, error) {
+			spanCtx, span := tracer.Start(ctx.Request.Context(), "server."+operationID)
+			defer span.End()
+
+			ctx.Request = ctx.Request.WithContext(spanCtx)
+
+			response, err := f(ctx, request)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return response, err
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Завершённые спаны. Трейсеры пакета привязываются к первому
+// глобальному провайдеру, поэтому он задаётся один раз на все тесты
+var exporter = tracetest.NewInMemoryExporter()
+
+func TestMain(m *testing.M) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	os.Exit(m.Run())
+}
+
+// Менеджер транзакций, просто вызывающий функцию
+type txManager struct{}
+
+func (txManager) Do(ctx context.Context, f func(context.Context) error) error {
+	return f(ctx)
+}
+
+func TestTxManager_Do(t *testing.T) {
+	exporter.Reset()
+	m := NewTxManager(txManager{})
+
+	var inner trace.SpanContext
+	err := m.Do(context.Background(), func(ctx context.Context) error {
+		inner = trace.SpanContextFromContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+
+	txErr := errors.New("serialization failure")
+	err = m.Do(context.Background(), func(context.Context) error {
+		return txErr
+	})
+	require.ErrorIs(t, err, txErr)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	// Функция выполняется внутри спана транзакции
+	assert.Equal(t, "txManager.Do", spans[0].Name)
+	assert.Equal(t, spans[0].SpanContext.SpanID(), inner.SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+}
+
+func TestLogger(t *testing.T) {
+
+	var buf bytes.Buffer
+	log := slog.New(slog.NewTextHandler(&buf, nil))
+
+	// Без спана логгер не меняется
+	assert.Same(t, log, Logger(context.Background(), log))
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "op")
+	defer span.End()
+
+	Logger(ctx, log).Info("message")
+	assert.Contains(t, buf.String(), "trace_id="+span.SpanContext().TraceID().String())
+	assert.Contains(t, buf.String(), "span_id="+span.SpanContext().SpanID().String())
+}
+
+func TestStrictMiddleware(t *testing.T) {
+	exporter.Reset()
+	gin.SetMode(gin.TestMode)
+
+	var handlerSpan trace.SpanContext
+	handler := StrictMiddleware()(func(ctx *gin.Context, request any) (any, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return nil, nil
+	}, "PostPullRequestCreate")
+
+	engine := gin.New()
+	engine.ContextWithFallback = true
+	engine.POST("/pullRequest/create", func(c *gin.Context) {
+		_, err := handler(c, nil)
+		require.NoError(t, err)
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "server.PostPullRequestCreate", spans[0].Name)
+	// Спан доступен через *gin.Context, который получают обработчики
+	assert.Equal(t, spans[0].SpanContext.SpanID(), handlerSpan.SpanID())
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var tracer = otel.Tracer("github.com/iskanye/avito-tech-internship/internal/tracing")
+
+type TransactionManager interface {
+	Do(
+		ctx context.Context,
+		f func(context.Context) error,
+	) error
+}
+
+// Менеджер транзакций, создающий спан на каждую транзакцию
+type TxManager struct {
+	manager TransactionManager
+}
+
+func NewTxManager(manager TransactionManager) *TxManager {
+	return &TxManager{
+		manager: manager,
+	}
+}
+
+func (m *TxManager) Do(
+	ctx context.Context,
+	f func(context.Context) error,
+) error {
+	ctx, span := tracer.Start(ctx, "txManager.Do")
+	defer span.End()
+
+	err := m.manager.Do(ctx, f)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
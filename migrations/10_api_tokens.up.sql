@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS api_tokens
+(
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL,
+    team_name TEXT,
+    created_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id BIGSERIAL PRIMARY KEY,
+    actor TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    pull_request_id TEXT,
+    team_name TEXT,
+    user_id TEXT,
+    before JSONB,
+    after JSONB,
+    request_id TEXT,
+    occurred_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_pull_request_idx
+    ON audit_events (pull_request_id, id) WHERE pull_request_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_team_idx
+    ON audit_events (team_name, id) WHERE team_name IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_user_idx
+    ON audit_events (user_id, id) WHERE user_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_occurred_at_idx
+    ON audit_events (occurred_at);
+
+-- Журнал только пополняется
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
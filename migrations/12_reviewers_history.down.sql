@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS reviewers_current_idx;
+
+-- Снятые ревьюверы не хранились до истории назначений
+DELETE FROM reviewers
+WHERE unassigned_at IS NOT NULL;
+
+ALTER TABLE reviewers
+    DROP COLUMN IF EXISTS unassigned_at,
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS reason,
+    DROP COLUMN IF EXISTS id;
@@ -0,0 +1,19 @@
+ALTER TABLE reviewers
+    ADD COLUMN IF NOT EXISTS id BIGSERIAL PRIMARY KEY,
+    ADD COLUMN IF NOT EXISTS reason TEXT NOT NULL DEFAULT 'initial',
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS unassigned_at TIMESTAMP;
+
+-- Время назначения существующих ревьюверов неизвестно, берём время создания PR
+UPDATE reviewers r
+SET assigned_at = p.created_at
+FROM pull_requests p
+WHERE r.pull_request_id = p.id AND r.assigned_at IS NULL;
+
+ALTER TABLE reviewers
+    ALTER COLUMN assigned_at SET NOT NULL,
+    ALTER COLUMN reason DROP DEFAULT;
+
+-- Текущие ревьюверы PR - строки без unassigned_at
+CREATE INDEX IF NOT EXISTS reviewers_current_idx
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS pull_requests_status_merged_at_idx;
+DROP TABLE IF EXISTS merge_time_rollup_state;
+DROP TABLE IF EXISTS merge_time_rollups;
@@ -0,0 +1,22 @@
+-- Время до мерджа PR, свёрнутое по командам и дням мерджа
+CREATE TABLE IF NOT EXISTS merge_time_rollups
+(
+    team_id INTEGER NOT NULL REFERENCES teams (id),
+    day DATE NOT NULL,
+    merge_seconds DOUBLE PRECISION[] NOT NULL,
+    PRIMARY KEY (team_id, day)
+);
+
+-- Дни до rolled_until уже свёрнуты и берутся из merge_time_rollups
+CREATE TABLE IF NOT EXISTS merge_time_rollup_state
+(
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    rolled_until TIMESTAMP NOT NULL
+);
+
+INSERT INTO merge_time_rollup_state (rolled_until)
+VALUES ('-infinity')
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS pull_requests_status_merged_at_idx
+    ON pull_requests (status, merged_at);
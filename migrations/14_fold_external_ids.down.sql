@@ -0,0 +1,44 @@
+DROP INDEX IF EXISTS absences_user_id_idx;
+DROP INDEX IF EXISTS reviewers_user_id_idx;
+DROP INDEX IF EXISTS pull_requests_author_id_idx;
+DROP INDEX IF EXISTS users_team_id_idx;
+
+CREATE TABLE IF NOT EXISTS pull_requests_id
+(
+    id SERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO pull_requests_id (pull_request_id)
+SELECT pull_request_id FROM pull_requests ORDER BY id;
+
+DROP INDEX IF EXISTS pull_requests_pull_request_id_idx;
+ALTER TABLE pull_requests RENAME COLUMN pull_request_id TO external_id;
+ALTER TABLE pull_requests ADD COLUMN pull_request_id INTEGER REFERENCES pull_requests_id (id);
+
+UPDATE pull_requests p
+SET pull_request_id = i.id
+FROM pull_requests_id i
+WHERE p.external_id = i.pull_request_id;
+
+ALTER TABLE pull_requests DROP COLUMN external_id;
+
+CREATE TABLE IF NOT EXISTS users_id
+(
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO users_id (user_id)
+SELECT user_id FROM users ORDER BY id;
+
+DROP INDEX IF EXISTS users_user_id_idx;
+ALTER TABLE users RENAME COLUMN user_id TO external_id;
+ALTER TABLE users ADD COLUMN user_id INTEGER UNIQUE REFERENCES users_id (id);
+
+UPDATE users u
+SET user_id = i.id
+FROM users_id i
+WHERE u.external_id = i.user_id;
+
+ALTER TABLE users DROP COLUMN external_id;
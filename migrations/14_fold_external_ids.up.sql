@@ -0,0 +1,39 @@
+-- Внешние ID пользователей и PR хранятся прямо в users и pull_requests,
+-- а не в отдельных таблицах users_id и pull_requests_id. Внутренние ID
+-- и внешние ключи на них остаются прежними
+ALTER TABLE users ADD COLUMN IF NOT EXISTS external_id TEXT;
+
+UPDATE users u
+SET external_id = i.user_id
+FROM users_id i
+WHERE u.user_id = i.id;
+
+ALTER TABLE users DROP COLUMN user_id;
+ALTER TABLE users RENAME COLUMN external_id TO user_id;
+ALTER TABLE users ALTER COLUMN user_id SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_user_id_idx ON users (user_id);
+
+DROP TABLE IF EXISTS users_id;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS external_id TEXT;
+
+UPDATE pull_requests p
+SET external_id = i.pull_request_id
+FROM pull_requests_id i
+WHERE p.pull_request_id = i.id;
+
+ALTER TABLE pull_requests DROP COLUMN pull_request_id;
+ALTER TABLE pull_requests RENAME COLUMN external_id TO pull_request_id;
+ALTER TABLE pull_requests ALTER COLUMN pull_request_id SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS pull_requests_pull_request_id_idx
+    ON pull_requests (pull_request_id);
+
+DROP TABLE IF EXISTS pull_requests_id;
+
+-- Соединения по внешним ключам при выборке данных одним запросом
+CREATE INDEX IF NOT EXISTS users_team_id_idx ON users (team_id);
+CREATE INDEX IF NOT EXISTS pull_requests_author_id_idx ON pull_requests (author_id);
+CREATE INDEX IF NOT EXISTS reviewers_user_id_idx ON reviewers (user_id);
+CREATE INDEX IF NOT EXISTS absences_user_id_idx ON absences (user_id);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS pull_requests_created_at_idx;
+DROP INDEX IF EXISTS vcs_logins_user_id_idx;
+DROP INDEX IF EXISTS reviewers_pull_request_id_idx;
+
+DROP TRIGGER IF EXISTS reviewers_not_author ON reviewers;
+DROP FUNCTION IF EXISTS reviewers_not_author();
+
+DROP INDEX IF EXISTS reviewers_current_key;
+CREATE INDEX IF NOT EXISTS reviewers_current_idx
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+
+ALTER TABLE reviewers
+    ALTER COLUMN pull_request_id DROP NOT NULL,
+    ALTER COLUMN user_id DROP NOT NULL;
@@ -0,0 +1,63 @@
+-- Назначение без PR или ревьювера ничего не значит
+DELETE FROM reviewers
+WHERE pull_request_id IS NULL OR user_id IS NULL;
+
+ALTER TABLE reviewers
+    ALTER COLUMN pull_request_id SET NOT NULL,
+    ALTER COLUMN user_id SET NOT NULL;
+
+-- Повторные текущие назначения, оставшиеся после гонок, снимаются,
+-- остаётся самое раннее из них
+UPDATE reviewers r
+SET unassigned_at = r.assigned_at
+WHERE
+    r.unassigned_at IS NULL AND
+    EXISTS (
+        SELECT 1
+        FROM reviewers d
+        WHERE
+            d.pull_request_id = r.pull_request_id AND
+            d.user_id = r.user_id AND
+            d.unassigned_at IS NULL AND
+            d.id < r.id
+    );
+
+-- Автор, назначенный ревьювером своего PR, снимается
+UPDATE reviewers r
+SET unassigned_at = r.assigned_at
+FROM pull_requests p
+WHERE r.pull_request_id = p.id AND r.user_id = p.author_id AND r.unassigned_at IS NULL;
+
+-- Пользователь назначен на PR не больше одного раза одновременно
+DROP INDEX IF EXISTS reviewers_current_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS reviewers_current_key
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+
+-- CHECK не может ссылаться на другую таблицу, поэтому то, что автор
+-- не ревьюит свой PR, проверяет триггер. Ошибка выглядит как нарушение
+-- CHECK с именем reviewers_not_author
+CREATE OR REPLACE FUNCTION reviewers_not_author() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pull_requests
+        WHERE id = NEW.pull_request_id AND author_id = NEW.user_id
+    ) THEN
+        RAISE EXCEPTION 'author cannot review their own pull request'
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'reviewers_not_author';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reviewers_not_author
+    BEFORE INSERT OR UPDATE OF pull_request_id, user_id ON reviewers
+    FOR EACH ROW EXECUTE FUNCTION reviewers_not_author();
+
+-- История назначений PR, поиск по логинам пользователя и сортировка
+-- PR по времени создания
+CREATE INDEX IF NOT EXISTS reviewers_pull_request_id_idx
+    ON reviewers (pull_request_id, assigned_at);
+CREATE INDEX IF NOT EXISTS vcs_logins_user_id_idx ON vcs_logins (user_id);
+CREATE INDEX IF NOT EXISTS pull_requests_created_at_idx ON pull_requests (created_at);
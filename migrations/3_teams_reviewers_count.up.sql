@@ -0,0 +1,2 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS reviewers_count INTEGER NOT NULL DEFAULT 2;
@@ -0,0 +1,2 @@
+ALTER TABLE reviewers
+    DROP COLUMN IF EXISTS verdict;
@@ -0,0 +1,2 @@
+ALTER TABLE reviewers
+    ADD COLUMN IF NOT EXISTS verdict TEXT;
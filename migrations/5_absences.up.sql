@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS absences
+(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    reassigned BOOLEAN NOT NULL DEFAULT FALSE
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS vcs_logins
+(
+    provider TEXT NOT NULL,
+    login TEXT NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    PRIMARY KEY (provider, login)
+);
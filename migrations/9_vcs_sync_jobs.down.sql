@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vcs_sync_jobs;
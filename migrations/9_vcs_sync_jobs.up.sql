@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS vcs_sync_jobs
+(
+    id BIGSERIAL PRIMARY KEY,
+    provider TEXT NOT NULL,
+    repository TEXT NOT NULL,
+    number INTEGER NOT NULL,
+    request_logins TEXT[] NOT NULL DEFAULT '{}',
+    remove_logins TEXT[] NOT NULL DEFAULT '{}',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    done_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS vcs_sync_jobs_pending_idx
+    ON vcs_sync_jobs (next_attempt_at)
+    WHERE done_at IS NULL AND failed_at IS NULL;
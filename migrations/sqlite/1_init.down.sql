@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS merge_time_rollup_state;
+DROP TABLE IF EXISTS merge_time_rollups;
+DROP TABLE IF EXISTS audit_events;
+DROP TABLE IF EXISTS api_tokens;
+DROP TABLE IF EXISTS vcs_sync_jobs;
+DROP TABLE IF EXISTS vcs_logins;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+DROP TABLE IF EXISTS absences;
+DROP TABLE IF EXISTS reviewers;
+DROP TABLE IF EXISTS pull_requests;
+DROP TABLE IF EXISTS pull_requests_id;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS users_id;
+DROP TABLE IF EXISTS teams;
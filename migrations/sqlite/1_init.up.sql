@@ -0,0 +1,194 @@
+-- Схема совпадает со схемой PostgreSQL после всех её миграций.
+-- Время хранится текстом в UTC с фиксированной длиной дробной части,
+-- поэтому сравнение строк совпадает со сравнением моментов времени.
+-- Массивы хранятся JSON массивами
+CREATE TABLE IF NOT EXISTS teams
+(
+    id INTEGER PRIMARY KEY,
+    team_name TEXT NOT NULL UNIQUE,
+    reviewers_count INTEGER NOT NULL DEFAULT 2
+);
+
+CREATE TABLE IF NOT EXISTS users_id
+(
+    id INTEGER PRIMARY KEY,
+    user_id TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS users
+(
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER UNIQUE REFERENCES users_id (id),
+    username TEXT NOT NULL,
+    team_id INTEGER REFERENCES teams (id),
+    is_active BOOLEAN NOT NULL,
+    max_open_reviews INTEGER
+);
+
+CREATE TABLE IF NOT EXISTS pull_requests_id
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS pull_requests
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id INTEGER REFERENCES pull_requests_id (id),
+    pull_request_name TEXT,
+    author_id INTEGER REFERENCES users (id),
+    status TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    merged_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS pull_requests_status_merged_at_idx
+    ON pull_requests (status, merged_at);
+
+CREATE TABLE IF NOT EXISTS reviewers
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id INTEGER REFERENCES pull_requests (id),
+    user_id INTEGER REFERENCES users (id),
+    verdict TEXT,
+    reason TEXT NOT NULL,
+    assigned_at TIMESTAMP NOT NULL,
+    unassigned_at TIMESTAMP
+);
+
+-- Текущие ревьюверы PR - строки без unassigned_at
+CREATE INDEX IF NOT EXISTS reviewers_current_idx
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS absences
+(
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    reassigned BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id INTEGER PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT NOT NULL DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id INTEGER PRIMARY KEY,
+    webhook_id INTEGER NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    delivered_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx
+    ON webhook_deliveries (next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS vcs_logins
+(
+    provider TEXT NOT NULL,
+    login TEXT NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    PRIMARY KEY (provider, login)
+);
+
+CREATE TABLE IF NOT EXISTS vcs_sync_jobs
+(
+    id INTEGER PRIMARY KEY,
+    provider TEXT NOT NULL,
+    repository TEXT NOT NULL,
+    number INTEGER NOT NULL,
+    request_logins TEXT NOT NULL DEFAULT '[]',
+    remove_logins TEXT NOT NULL DEFAULT '[]',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    done_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS vcs_sync_jobs_pending_idx
+    ON vcs_sync_jobs (next_attempt_at)
+    WHERE done_at IS NULL AND failed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS api_tokens
+(
+    id INTEGER PRIMARY KEY,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL,
+    team_name TEXT,
+    created_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id INTEGER PRIMARY KEY,
+    actor TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    pull_request_id TEXT,
+    team_name TEXT,
+    user_id TEXT,
+    before TEXT,
+    after TEXT,
+    request_id TEXT,
+    occurred_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_pull_request_idx
+    ON audit_events (pull_request_id, id) WHERE pull_request_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_team_idx
+    ON audit_events (team_name, id) WHERE team_name IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_user_idx
+    ON audit_events (user_id, id) WHERE user_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_occurred_at_idx
+    ON audit_events (occurred_at);
+
+-- Журнал только пополняется
+CREATE TRIGGER IF NOT EXISTS audit_events_no_update
+    BEFORE UPDATE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_events_no_delete
+    BEFORE DELETE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+
+-- Время до мерджа PR, свёрнутое по командам и дням мерджа (YYYY-MM-DD)
+CREATE TABLE IF NOT EXISTS merge_time_rollups
+(
+    team_id INTEGER NOT NULL REFERENCES teams (id),
+    day TEXT NOT NULL,
+    merge_seconds TEXT NOT NULL,
+    PRIMARY KEY (team_id, day)
+);
+
+-- Дни до rolled_until уже свёрнуты и берутся из merge_time_rollups
+CREATE TABLE IF NOT EXISTS merge_time_rollup_state
+(
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    rolled_until TIMESTAMP NOT NULL
+);
+
+INSERT INTO merge_time_rollup_state (id, rolled_until)
+VALUES (1, '0001-01-01 00:00:00.000000000')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,64 @@
+DROP INDEX IF EXISTS absences_user_id_idx;
+DROP INDEX IF EXISTS reviewers_user_id_idx;
+DROP INDEX IF EXISTS pull_requests_author_id_idx;
+DROP INDEX IF EXISTS users_team_id_idx;
+
+CREATE TABLE IF NOT EXISTS pull_requests_id
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO pull_requests_id (pull_request_id)
+SELECT pull_request_id FROM pull_requests ORDER BY id;
+
+CREATE TABLE pull_requests_old
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id INTEGER REFERENCES pull_requests_id (id),
+    pull_request_name TEXT,
+    author_id INTEGER REFERENCES users (id),
+    status TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    merged_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO pull_requests_old (
+    id, pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+)
+SELECT p.id, i.id, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at
+FROM pull_requests p
+JOIN pull_requests_id i ON p.pull_request_id = i.pull_request_id;
+
+DROP TABLE pull_requests;
+ALTER TABLE pull_requests_old RENAME TO pull_requests;
+
+CREATE INDEX IF NOT EXISTS pull_requests_status_merged_at_idx
+    ON pull_requests (status, merged_at);
+
+CREATE TABLE IF NOT EXISTS users_id
+(
+    id INTEGER PRIMARY KEY,
+    user_id TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO users_id (user_id)
+SELECT user_id FROM users ORDER BY id;
+
+CREATE TABLE users_old
+(
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER UNIQUE REFERENCES users_id (id),
+    username TEXT NOT NULL,
+    team_id INTEGER REFERENCES teams (id),
+    is_active BOOLEAN NOT NULL,
+    max_open_reviews INTEGER
+);
+
+INSERT INTO users_old (id, user_id, username, team_id, is_active, max_open_reviews)
+SELECT u.id, i.id, u.username, u.team_id, u.is_active, u.max_open_reviews
+FROM users u
+JOIN users_id i ON u.user_id = i.user_id;
+
+DROP TABLE users;
+ALTER TABLE users_old RENAME TO users;
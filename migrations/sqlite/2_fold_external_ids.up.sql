@@ -0,0 +1,60 @@
+-- Внешние ID пользователей и PR хранятся прямо в users и pull_requests,
+-- а не в отдельных таблицах users_id и pull_requests_id. Внутренние ID
+-- и внешние ключи на них остаются прежними.
+-- SQLite не удаляет столбцы с UNIQUE и REFERENCES, поэтому таблицы
+-- пересоздаются. Миграции выполняются без PRAGMA foreign_keys, а
+-- ссылки других таблиц на users и pull_requests после переименования
+-- указывают на новые таблицы
+CREATE TABLE users_new
+(
+    id INTEGER PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    team_id INTEGER REFERENCES teams (id),
+    is_active BOOLEAN NOT NULL,
+    max_open_reviews INTEGER
+);
+
+INSERT INTO users_new (id, user_id, username, team_id, is_active, max_open_reviews)
+SELECT u.id, i.user_id, u.username, u.team_id, u.is_active, u.max_open_reviews
+FROM users u
+JOIN users_id i ON u.user_id = i.id;
+
+DROP TABLE users;
+ALTER TABLE users_new RENAME TO users;
+DROP TABLE users_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_user_id_idx ON users (user_id);
+
+CREATE TABLE pull_requests_new
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id TEXT NOT NULL,
+    pull_request_name TEXT,
+    author_id INTEGER REFERENCES users (id),
+    status TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    merged_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO pull_requests_new (
+    id, pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+)
+SELECT p.id, i.pull_request_id, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at
+FROM pull_requests p
+JOIN pull_requests_id i ON p.pull_request_id = i.id;
+
+DROP TABLE pull_requests;
+ALTER TABLE pull_requests_new RENAME TO pull_requests;
+DROP TABLE pull_requests_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS pull_requests_pull_request_id_idx
+    ON pull_requests (pull_request_id);
+CREATE INDEX IF NOT EXISTS pull_requests_status_merged_at_idx
+    ON pull_requests (status, merged_at);
+
+-- Соединения по внешним ключам при выборке данных одним запросом
+CREATE INDEX IF NOT EXISTS users_team_id_idx ON users (team_id);
+CREATE INDEX IF NOT EXISTS pull_requests_author_id_idx ON pull_requests (author_id);
+CREATE INDEX IF NOT EXISTS reviewers_user_id_idx ON reviewers (user_id);
+CREATE INDEX IF NOT EXISTS absences_user_id_idx ON absences (user_id);
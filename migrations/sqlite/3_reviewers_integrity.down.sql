@@ -0,0 +1,27 @@
+DROP INDEX IF EXISTS pull_requests_created_at_idx;
+DROP INDEX IF EXISTS vcs_logins_user_id_idx;
+
+CREATE TABLE reviewers_old
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id INTEGER REFERENCES pull_requests (id),
+    user_id INTEGER REFERENCES users (id),
+    verdict TEXT,
+    reason TEXT NOT NULL,
+    assigned_at TIMESTAMP NOT NULL,
+    unassigned_at TIMESTAMP
+);
+
+INSERT INTO reviewers_old (
+    id, pull_request_id, user_id, verdict, reason, assigned_at, unassigned_at
+)
+SELECT id, pull_request_id, user_id, verdict, reason, assigned_at, unassigned_at
+FROM reviewers;
+
+-- Индексы и триггеры удаляются вместе с таблицей
+DROP TABLE reviewers;
+ALTER TABLE reviewers_old RENAME TO reviewers;
+
+CREATE INDEX IF NOT EXISTS reviewers_current_idx
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+CREATE INDEX IF NOT EXISTS reviewers_user_id_idx ON reviewers (user_id);
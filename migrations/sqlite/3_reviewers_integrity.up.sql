@@ -0,0 +1,81 @@
+-- SQLite не добавляет NOT NULL к существующим столбцам, поэтому таблица
+-- reviewers пересоздаётся. Назначение без PR или ревьювера ничего не значит
+CREATE TABLE reviewers_new
+(
+    id INTEGER PRIMARY KEY,
+    pull_request_id INTEGER NOT NULL REFERENCES pull_requests (id),
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    verdict TEXT,
+    reason TEXT NOT NULL,
+    assigned_at TIMESTAMP NOT NULL,
+    unassigned_at TIMESTAMP
+);
+
+INSERT INTO reviewers_new (
+    id, pull_request_id, user_id, verdict, reason, assigned_at, unassigned_at
+)
+SELECT id, pull_request_id, user_id, verdict, reason, assigned_at, unassigned_at
+FROM reviewers
+WHERE pull_request_id IS NOT NULL AND user_id IS NOT NULL;
+
+DROP TABLE reviewers;
+ALTER TABLE reviewers_new RENAME TO reviewers;
+
+-- Повторные текущие назначения, оставшиеся после гонок, снимаются,
+-- остаётся самое раннее из них
+UPDATE reviewers
+SET unassigned_at = assigned_at
+WHERE
+    unassigned_at IS NULL AND
+    EXISTS (
+        SELECT 1
+        FROM reviewers d
+        WHERE
+            d.pull_request_id = reviewers.pull_request_id AND
+            d.user_id = reviewers.user_id AND
+            d.unassigned_at IS NULL AND
+            d.id < reviewers.id
+    );
+
+-- Автор, назначенный ревьювером своего PR, снимается
+UPDATE reviewers
+SET unassigned_at = assigned_at
+WHERE
+    unassigned_at IS NULL AND
+    user_id = (SELECT author_id FROM pull_requests WHERE id = reviewers.pull_request_id);
+
+-- Пользователь назначен на PR не больше одного раза одновременно
+CREATE UNIQUE INDEX IF NOT EXISTS reviewers_current_key
+    ON reviewers (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+CREATE INDEX IF NOT EXISTS reviewers_user_id_idx ON reviewers (user_id);
+
+-- CHECK не может ссылаться на другую таблицу, поэтому то, что автор
+-- не ревьюит свой PR, проверяют триггеры
+CREATE TRIGGER IF NOT EXISTS reviewers_not_author_insert
+    BEFORE INSERT ON reviewers
+    WHEN EXISTS (
+        SELECT 1
+        FROM pull_requests
+        WHERE id = NEW.pull_request_id AND author_id = NEW.user_id
+    )
+BEGIN
+    SELECT RAISE(ABORT, 'reviewers_not_author');
+END;
+
+CREATE TRIGGER IF NOT EXISTS reviewers_not_author_update
+    BEFORE UPDATE OF pull_request_id, user_id ON reviewers
+    WHEN EXISTS (
+        SELECT 1
+        FROM pull_requests
+        WHERE id = NEW.pull_request_id AND author_id = NEW.user_id
+    )
+BEGIN
+    SELECT RAISE(ABORT, 'reviewers_not_author');
+END;
+
+-- История назначений PR, поиск по логинам пользователя и сортировка
+-- PR по времени создания
+CREATE INDEX IF NOT EXISTS reviewers_pull_request_id_idx
+    ON reviewers (pull_request_id, assigned_at);
+CREATE INDEX IF NOT EXISTS vcs_logins_user_id_idx ON vcs_logins (user_id);
+CREATE INDEX IF NOT EXISTS pull_requests_created_at_idx ON pull_requests (created_at);
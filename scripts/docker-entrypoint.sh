@@ -1,3 +1,3 @@
 #!/bin/bash
-./migrator --migrations-path=./migrations
+./migrator --migrations-path=${MIGRATIONS_PATH:-./migrations}
 ./prassignment
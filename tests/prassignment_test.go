@@ -1,6 +1,14 @@
 package tests
 
 import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -19,6 +27,21 @@ const (
 	PR_EXISTS    = "PR id already exists"
 	NOT_ASSIGNED = "reviewer is not assigned to this PR"
 	NO_CANDIDATE = "no active replacement candidate in team"
+
+	INVALID_REVIEWERS_COUNT = "reviewers_count must be positive"
+	INVALID_VERDICT         = "unknown review verdict"
+	PR_MERGED               = "PR is already merged"
+	PR_CLOSED               = "PR is closed"
+	PR_DRAFT                = "PR is a draft"
+	INVALID_CURSOR          = "invalid cursor"
+	INVALID_ABSENCE         = "absence must end after it starts"
+
+	INVALID_MAX_OPEN_REVIEWS = "max_open_reviews must not be negative"
+	INVALID_WEBHOOK_URL      = "webhook url must be an absolute http(s) URL"
+	UNKNOWN_WEBHOOK_EVENT    = "unknown webhook event"
+	INVALID_SIGNATURE        = "invalid webhook signature"
+	UNKNOWN_VCS_LOGIN        = "VCS login is not mapped to a user"
+	UNKNOWN_TOKEN_ROLE       = "unknown token role"
 )
 
 // Тесты команд
@@ -97,7 +120,273 @@ func TestTeams_DeactivateTeam_Success(t *testing.T) {
 		team.Members[i].IsActive = false
 	}
 
-	suite.CheckTeamsEqual(t, team, getTeamResp.JSON200)
+	suite.CheckTeamsEqual(t, team, &api.Team{
+		TeamName: getTeamResp.JSON200.TeamName,
+		Members:  getTeamResp.JSON200.Members,
+	})
+	assert.Nil(t, getTeamResp.JSON200.Reassignments)
+}
+
+func TestTeams_AddTeam_ReviewersCount(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Команда из 5 активных человек, которой нужно 3 ревьювера
+	team := suite.RandomTeam(5, func() bool { return true })
+	reviewersCount := 3
+	team.ReviewersCount = &reviewersCount
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	// Получить команду
+	getTeam, err := s.Client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getTeam.JSON200)
+	require.NotNil(t, getTeam.JSON200.ReviewersCount)
+	assert.Equal(t, reviewersCount, *getTeam.JSON200.ReviewersCount)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	assert.Len(t, addPullRequest.JSON201.Pr.AssignedReviewers, reviewersCount)
+}
+
+func TestTeams_AddTeam_InvalidReviewersCount(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	reviewersCount := 0
+	team.ReviewersCount = &reviewersCount
+
+	// Добавить команду с неверным количеством ревьюверов
+	resp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, resp.JSON400.Error.Code)
+	assert.Equal(t, INVALID_REVIEWERS_COUNT, resp.JSON400.Error.Message)
+}
+
+func TestTeams_UpdateTeam_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Команда из 4 активных человек с количеством ревьюверов по умолчанию
+	team := suite.RandomTeam(4, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	// Обновляем количество ревьюверов
+	updateTeam, err := s.Client.PostTeamUpdateWithResponse(ctx, api.PostTeamUpdateJSONRequestBody{
+		TeamName:       team.TeamName,
+		ReviewersCount: 1,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, updateTeam.JSON200)
+	suite.CheckTeamsEqual(t, team, updateTeam.JSON200)
+	require.NotNil(t, updateTeam.JSON200.ReviewersCount)
+	assert.Equal(t, 1, *updateTeam.JSON200.ReviewersCount)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	assert.Len(t, addPullRequest.JSON201.Pr.AssignedReviewers, 1)
+}
+
+func TestTeams_UpdateTeam_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Обновить команду, которая не существует
+	resp, err := s.Client.PostTeamUpdateWithResponse(ctx, api.PostTeamUpdateJSONRequestBody{
+		TeamName:       gofakeit.UUID(),
+		ReviewersCount: 1,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON404)
+	assert.Equal(t, api.NOTFOUND, resp.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, resp.JSON404.Error.Message)
+}
+
+func TestTeams_TeamStats_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+	team.Members[2].IsActive = false
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	// Создаем 4 пул реквеста, которые приведём в разные статусы
+	pullRequests := make([]*api.PullRequest, 4)
+	for i := range pullRequests {
+		pullRequests[i] = suite.RandomPullRequest(team.Members[0].UserId)
+		draft := i == 0
+
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequests[i].PullRequestId,
+			PullRequestName: pullRequests[i].PullRequestName,
+			AuthorId:        pullRequests[i].AuthorId,
+			Draft:           &draft,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+	}
+
+	// Второй мерджим, третий закрываем
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequests[1].PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	closePR, err := s.Client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+		PullRequestId: pullRequests[2].PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, closePR.JSON200)
+
+	// Получаем статистику
+	stats, err := s.Client.GetTeamStatsWithResponse(ctx, &api.GetTeamStatsParams{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.JSON200)
+	assert.Equal(t, team.TeamName, stats.JSON200.TeamName)
+	assert.Equal(t, 3, stats.JSON200.Users)
+	assert.Equal(t, 2, stats.JSON200.ActiveUsers)
+	assert.Equal(t, 1, stats.JSON200.InactiveUsers)
+	assert.Equal(t, 4, stats.JSON200.PullRequests)
+	assert.Equal(t, 1, stats.JSON200.DraftPullRequests)
+	assert.Equal(t, 1, stats.JSON200.OpenPullRequests)
+	assert.Equal(t, 1, stats.JSON200.MergedPullRequests)
+	assert.Equal(t, 1, stats.JSON200.ClosedPullRequests)
+}
+
+func TestTeams_TimingStats_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	// Создаем 3 пул реквеста, первый мерджим
+	pullRequests := make([]*api.PullRequest, 3)
+	for i := range pullRequests {
+		pullRequests[i] = suite.RandomPullRequest(team.Members[0].UserId)
+
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequests[i].PullRequestId,
+			PullRequestName: pullRequests[i].PullRequestName,
+			AuthorId:        pullRequests[i].AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+	}
+
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequests[0].PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	// Получаем статистику с одним самым старым PR
+	limit := 1
+	stats, err := s.Client.GetTeamStatsTimingWithResponse(ctx, &api.GetTeamStatsTimingParams{
+		TeamName: team.TeamName,
+		Limit:    &limit,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.JSON200)
+	assert.Equal(t, team.TeamName, stats.JSON200.TeamName)
+	assert.Nil(t, stats.JSON200.From)
+
+	assert.Equal(t, 1, stats.JSON200.MergedPullRequests)
+	require.NotNil(t, stats.JSON200.TimeToMerge.P50Seconds)
+	require.NotNil(t, stats.JSON200.TimeToMerge.P99Seconds)
+	assert.LessOrEqual(t, *stats.JSON200.TimeToMerge.P50Seconds, *stats.JSON200.TimeToMerge.P99Seconds)
+
+	// Оба открытых PR созданы только что
+	assert.Equal(t, 2, stats.JSON200.OpenPullRequests)
+	require.Len(t, stats.JSON200.OpenAgeDistribution, 5)
+	assert.Equal(t, api.Lt1h, stats.JSON200.OpenAgeDistribution[0].Bucket)
+	assert.Equal(t, 2, stats.JSON200.OpenAgeDistribution[0].Count)
+
+	// Время создания хранится с точностью до секунды, поэтому
+	// самым старым может оказаться любой из открытых PR
+	require.Len(t, stats.JSON200.OldestOpenPullRequests, 1)
+	assert.Contains(t,
+		[]string{pullRequests[1].PullRequestId, pullRequests[2].PullRequestId},
+		stats.JSON200.OldestOpenPullRequests[0].PullRequestId,
+	)
+
+	// Окно в прошлом не содержит PR
+	from := time.Now().Add(-2 * time.Hour)
+	to := time.Now().Add(-time.Hour)
+	stats, err = s.Client.GetTeamStatsTimingWithResponse(ctx, &api.GetTeamStatsTimingParams{
+		TeamName: team.TeamName,
+		From:     &from,
+		To:       &to,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.JSON200)
+	assert.Equal(t, 0, stats.JSON200.MergedPullRequests)
+	assert.Nil(t, stats.JSON200.TimeToMerge.P50Seconds)
+	assert.Equal(t, 0, stats.JSON200.OpenPullRequests)
+	assert.Empty(t, stats.JSON200.OldestOpenPullRequests)
+}
+
+func TestTeams_TimingStats_Invalid(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	// Конец окна раньше начала
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	stats, err := s.Client.GetTeamStatsTimingWithResponse(ctx, &api.GetTeamStatsTimingParams{
+		TeamName: team.TeamName,
+		From:     &from,
+		To:       &to,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, stats.JSON400.Error.Code)
+
+	// Несуществующая команда
+	stats, err = s.Client.GetTeamStatsTimingWithResponse(ctx, &api.GetTeamStatsTimingParams{
+		TeamName: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.JSON404)
+	assert.Equal(t, api.NOTFOUND, stats.JSON404.Error.Code)
 }
 
 // Тесты пользователей
@@ -206,22 +495,33 @@ func TestUsers_GetReview_NotFound(t *testing.T) {
 	assert.Equal(t, NOT_FOUND, getReview.JSON404.Error.Message)
 }
 
-// Тесты пул реквестов
-
-func TestPullRequests_Create_Success(t *testing.T) {
+func TestUsers_SetMaxOpenReviews_Success(t *testing.T) {
 	s, ctx := suite.New(t)
 
-	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	team := suite.RandomTeam(3, func() bool { return true })
+	author := team.Members[0].UserId
+	capped := team.Members[1].UserId
+	reviewer := team.Members[2].UserId
 
 	// Добавить команду
 	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
 	require.NoError(t, err)
 	require.NotEmpty(t, addTeam.JSON201)
-	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
 
-	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	// Запрещаем пользователю открытые ревью
+	maxOpenReviews := 0
+	setMax, err := s.Client.PostUsersSetMaxOpenReviewsWithResponse(ctx, api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+		UserId:         capped,
+		MaxOpenReviews: &maxOpenReviews,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setMax.JSON200)
+	require.NotEmpty(t, setMax.JSON200.User)
+	require.NotNil(t, setMax.JSON200.User.MaxOpenReviews)
+	assert.Equal(t, maxOpenReviews, *setMax.JSON200.User.MaxOpenReviews)
 
-	// Добавляем пул реквест
+	// Пользователь на пределе не назначается, и ответ говорит о нехватке ревьюверов
+	pullRequest := suite.RandomPullRequest(author)
 	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   pullRequest.PullRequestId,
 		PullRequestName: pullRequest.PullRequestName,
@@ -229,10 +529,21 @@ func TestPullRequests_Create_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotEmpty(t, addPullRequest.JSON201)
-	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+	assert.Equal(t, []string{reviewer}, addPullRequest.JSON201.Pr.AssignedReviewers)
+	require.NotNil(t, addPullRequest.JSON201.Pr.ReviewersMissing)
+	assert.Equal(t, 1, *addPullRequest.JSON201.Pr.ReviewersMissing)
+
+	// Снимаем ограничение
+	setMax, err = s.Client.PostUsersSetMaxOpenReviewsWithResponse(ctx, api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+		UserId: capped,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setMax.JSON200)
+	require.NotEmpty(t, setMax.JSON200.User)
+	assert.Nil(t, setMax.JSON200.User.MaxOpenReviews)
 }
 
-func TestPullRequests_Create_NoReviewers(t *testing.T) {
+func TestUsers_SetMaxOpenReviews_Invalid(t *testing.T) {
 	s, ctx := suite.New(t)
 
 	team := suite.RandomTeam(1, func() bool { return true })
@@ -241,36 +552,71 @@ func TestPullRequests_Create_NoReviewers(t *testing.T) {
 	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
 	require.NoError(t, err)
 	require.NotEmpty(t, addTeam.JSON201)
-	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
 
-	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	// Отрицательное ограничение
+	maxOpenReviews := -1
+	setMax, err := s.Client.PostUsersSetMaxOpenReviewsWithResponse(ctx, api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+		UserId:         team.Members[0].UserId,
+		MaxOpenReviews: &maxOpenReviews,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setMax.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, setMax.JSON400.Error.Code)
+	assert.Equal(t, INVALID_MAX_OPEN_REVIEWS, setMax.JSON400.Error.Message)
+}
 
-	// Добавляем пул реквест
-	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
-		PullRequestId:   pullRequest.PullRequestId,
-		PullRequestName: pullRequest.PullRequestName,
-		AuthorId:        pullRequest.AuthorId,
+func TestUsers_SetMaxOpenReviews_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	maxOpenReviews := 1
+	setMax, err := s.Client.PostUsersSetMaxOpenReviewsWithResponse(ctx, api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+		UserId:         gofakeit.UUID(),
+		MaxOpenReviews: &maxOpenReviews,
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, addPullRequest.JSON201)
-	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
-	assert.Empty(t, addPullRequest.JSON201.Pr.AssignedReviewers)
+	require.NotEmpty(t, setMax.JSON404)
+	assert.Equal(t, api.NOTFOUND, setMax.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, setMax.JSON404.Error.Message)
 }
 
-func TestPullRequests_Create_Dublicate(t *testing.T) {
+func TestUsers_Absence_Success(t *testing.T) {
 	s, ctx := suite.New(t)
 
-	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	team := suite.RandomTeam(2, func() bool { return true })
+	author := team.Members[0].UserId
+	reviewer := team.Members[1].UserId
 
 	// Добавить команду
 	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
 	require.NoError(t, err)
 	require.NotEmpty(t, addTeam.JSON201)
-	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
 
-	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	// Добавляем период отсутствия, покрывающий текущий момент
+	now := time.Now()
+	reason := gofakeit.Sentence(2)
+	addAbsence, err := s.Client.PostUsersAbsenceWithResponse(ctx, api.PostUsersAbsenceJSONRequestBody{
+		UserId:   reviewer,
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+		Reason:   &reason,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addAbsence.JSON201)
+	require.NotEmpty(t, addAbsence.JSON201.Absence)
+	assert.Equal(t, reviewer, addAbsence.JSON201.Absence.UserId)
+	assert.Equal(t, reason, addAbsence.JSON201.Absence.Reason)
+
+	// Получаем периоды отсутствия
+	getAbsences, err := s.Client.GetUsersAbsenceWithResponse(ctx, &api.GetUsersAbsenceParams{
+		UserId: reviewer,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getAbsences.JSON200)
+	require.Len(t, getAbsences.JSON200.Absences, 1)
+	assert.Equal(t, addAbsence.JSON201.Absence.AbsenceId, getAbsences.JSON200.Absences[0].AbsenceId)
 
-	// Добавляем пул реквест
+	// Отсутствующий пользователь не назначается ревьювером
+	pullRequest := suite.RandomPullRequest(author)
 	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   pullRequest.PullRequestId,
 		PullRequestName: pullRequest.PullRequestName,
@@ -278,96 +624,384 @@ func TestPullRequests_Create_Dublicate(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotEmpty(t, addPullRequest.JSON201)
-	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+	assert.Empty(t, addPullRequest.JSON201.Pr.AssignedReviewers)
 
-	// Вставляем дубликат
+	// Удаляем период отсутствия
+	deleteAbsence, err := s.Client.DeleteUsersAbsenceWithResponse(ctx, &api.DeleteUsersAbsenceParams{
+		UserId:    reviewer,
+		AbsenceId: addAbsence.JSON201.Absence.AbsenceId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 204, deleteAbsence.StatusCode())
+
+	// После удаления пользователь снова назначается ревьювером
+	pullRequest = suite.RandomPullRequest(author)
 	addPullRequest, err = s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   pullRequest.PullRequestId,
 		PullRequestName: pullRequest.PullRequestName,
 		AuthorId:        pullRequest.AuthorId,
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, addPullRequest.JSON409)
-	assert.Equal(t, api.PREXISTS, addPullRequest.JSON409.Error.Code)
-	assert.Equal(t, PR_EXISTS, addPullRequest.JSON409.Error.Message)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	assert.Equal(t, []string{reviewer}, addPullRequest.JSON201.Pr.AssignedReviewers)
 }
 
-func TestPullRequests_Create_NotFound(t *testing.T) {
+func TestUsers_Absence_InvalidPeriod(t *testing.T) {
 	s, ctx := suite.New(t)
 
-	pullRequest := suite.RandomPullRequest(gofakeit.UUID())
+	team := suite.RandomTeam(1, func() bool { return true })
 
-	// Добавляем пул реквест с несуществующим автором
-	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
-		PullRequestId:   pullRequest.PullRequestId,
-		PullRequestName: pullRequest.PullRequestName,
-		AuthorId:        pullRequest.AuthorId,
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	// Период заканчивается раньше, чем начинается
+	now := time.Now()
+	addAbsence, err := s.Client.PostUsersAbsenceWithResponse(ctx, api.PostUsersAbsenceJSONRequestBody{
+		UserId:   team.Members[0].UserId,
+		StartsAt: now,
+		EndsAt:   now.Add(-time.Hour),
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, addPullRequest.JSON404)
-	assert.Equal(t, api.NOTFOUND, addPullRequest.JSON404.Error.Code)
-	assert.Equal(t, NOT_FOUND, addPullRequest.JSON404.Error.Message)
+	require.NotEmpty(t, addAbsence.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, addAbsence.JSON400.Error.Code)
+	assert.Equal(t, INVALID_ABSENCE, addAbsence.JSON400.Error.Message)
 }
 
-func TestPullRequests_Merge_Success(t *testing.T) {
+func TestUsers_Absence_NotFound(t *testing.T) {
 	s, ctx := suite.New(t)
 
-	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	// Добавляем период отсутствия несуществующему пользователю
+	now := time.Now()
+	addAbsence, err := s.Client.PostUsersAbsenceWithResponse(ctx, api.PostUsersAbsenceJSONRequestBody{
+		UserId:   gofakeit.UUID(),
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addAbsence.JSON404)
+	assert.Equal(t, api.NOTFOUND, addAbsence.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, addAbsence.JSON404.Error.Message)
+
+	// Удаляем несуществующий период отсутствия
+	deleteAbsence, err := s.Client.DeleteUsersAbsenceWithResponse(ctx, &api.DeleteUsersAbsenceParams{
+		UserId:    gofakeit.UUID(),
+		AbsenceId: gofakeit.Int64(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, deleteAbsence.JSON404)
+	assert.Equal(t, api.NOTFOUND, deleteAbsence.JSON404.Error.Code)
+}
 
-	// Добавить команду
+func TestUsers_Stats_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Команда из 3 активных человек - оба не автора становятся ревьюверами
+	team := suite.RandomTeam(3, func() bool { return true })
 	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
 	require.NoError(t, err)
 	require.NotEmpty(t, addTeam.JSON201)
-	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
 
-	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	author := team.Members[0].UserId
+	merged := suite.RandomPullRequest(author)
+	open := suite.RandomPullRequest(author)
+	for _, pullRequest := range []*api.PullRequest{merged, open} {
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequest.PullRequestId,
+			PullRequestName: pullRequest.PullRequestName,
+			AuthorId:        pullRequest.AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+		require.Len(t, addPullRequest.JSON201.Pr.AssignedReviewers, 2)
+	}
 
-	// Добавляем пул реквест
-	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
-		PullRequestId:   pullRequest.PullRequestId,
-		PullRequestName: pullRequest.PullRequestName,
-		AuthorId:        pullRequest.AuthorId,
+	mergePullRequest, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: merged.PullRequestId,
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, addPullRequest.JSON201)
-	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
-
-	// Мерджим наш пул реквест
-	pullRequest.Status = api.PullRequestStatusMERGED
-	mergedAt := time.Now().Truncate(1)
-	pullRequest.MergedAt = &mergedAt
+	require.NotEmpty(t, mergePullRequest.JSON200)
 
-	// Мерджим пул реквест в сервисе
-	mergedPullRequest, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
-		PullRequestId: pullRequest.PullRequestId,
+	// Статистика автора
+	authorStats, err := s.Client.GetUsersStatsWithResponse(ctx, &api.GetUsersStatsParams{
+		UserId: author,
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, mergedPullRequest.JSON200)
-	suite.CheckPullRequestEqual(t, pullRequest, mergedPullRequest.JSON200.Pr)
+	require.NotEmpty(t, authorStats.JSON200)
+	assert.Equal(t, team.TeamName, authorStats.JSON200.TeamName)
+	assert.Equal(t, 2, authorStats.JSON200.PullRequestsAuthored)
+	assert.Equal(t, 0, authorStats.JSON200.ReviewsAssigned)
+	assert.Nil(t, authorStats.JSON200.MedianTimeToMergeSeconds)
+
+	// Статистика ревьювера
+	reviewer := team.Members[1].UserId
+	reviewerStats, err := s.Client.GetUsersStatsWithResponse(ctx, &api.GetUsersStatsParams{
+		UserId: reviewer,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reviewerStats.JSON200)
+	assert.Equal(t, 2, reviewerStats.JSON200.ReviewsAssigned)
+	assert.Equal(t, 1, reviewerStats.JSON200.OpenReviews)
+	assert.Equal(t, 1, reviewerStats.JSON200.MergedReviews)
+	assert.Equal(t, 0, reviewerStats.JSON200.ReassignedAway)
+	assert.Equal(t, 0, reviewerStats.JSON200.PullRequestsAuthored)
+	require.NotNil(t, reviewerStats.JSON200.MedianTimeToMergeSeconds)
+	assert.GreaterOrEqual(t, *reviewerStats.JSON200.MedianTimeToMergeSeconds, 0.0)
+
+	// Назначения до from не учитываются
+	from := time.Now().Add(time.Hour)
+	reviewerStats, err = s.Client.GetUsersStatsWithResponse(ctx, &api.GetUsersStatsParams{
+		UserId: reviewer,
+		From:   &from,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reviewerStats.JSON200)
+	assert.Equal(t, 0, reviewerStats.JSON200.ReviewsAssigned)
 
-	// Проверяем идемпотентность
-	mergedPullRequest, err = s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
-		PullRequestId: pullRequest.PullRequestId,
+	// Рейтинг команды: автор без ревью последний
+	leaderboard, err := s.Client.GetUsersStatsLeaderboardWithResponse(ctx, &api.GetUsersStatsLeaderboardParams{
+		TeamName: team.TeamName,
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, mergedPullRequest.JSON200)
-	suite.CheckPullRequestEqual(t, pullRequest, mergedPullRequest.JSON200.Pr)
+	require.NotEmpty(t, leaderboard.JSON200)
+	assert.Equal(t, team.TeamName, leaderboard.JSON200.TeamName)
+	require.Len(t, leaderboard.JSON200.Users, 3)
+	assert.Equal(t, author, leaderboard.JSON200.Users[2].UserId)
+	assert.Equal(t, 1, leaderboard.JSON200.Users[0].MergedReviews)
 }
 
-func TestPullRequests_Merge_NotFound(t *testing.T) {
+func TestUsers_Stats_NotFound(t *testing.T) {
 	s, ctx := suite.New(t)
 
-	// Мерджим пул реквест
-	mergedPullRequest, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
-		PullRequestId: gofakeit.UUID(),
+	stats, err := s.Client.GetUsersStatsWithResponse(ctx, &api.GetUsersStatsParams{
+		UserId: gofakeit.UUID(),
 	})
 	require.NoError(t, err)
-	require.NotEmpty(t, mergedPullRequest.JSON404)
-	assert.Equal(t, api.NOTFOUND, mergedPullRequest.JSON404.Error.Code)
-	assert.Equal(t, NOT_FOUND, mergedPullRequest.JSON404.Error.Message)
+	require.NotEmpty(t, stats.JSON404)
+	assert.Equal(t, api.NOTFOUND, stats.JSON404.Error.Code)
+
+	leaderboard, err := s.Client.GetUsersStatsLeaderboardWithResponse(ctx, &api.GetUsersStatsLeaderboardParams{
+		TeamName: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, leaderboard.JSON404)
+	assert.Equal(t, api.NOTFOUND, leaderboard.JSON404.Error.Code)
 }
 
-func TestPullRequests_ReassignReviewer_Success(t *testing.T) {
+// Тесты пул реквестов
+
+func TestPullRequests_Create_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+}
+
+func TestPullRequests_Create_NoReviewers(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(1, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+	assert.Empty(t, addPullRequest.JSON201.Pr.AssignedReviewers)
+}
+
+func TestPullRequests_Create_LeastLoaded(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Создаем команду из 4 активных человек, автор и трое возможных ревьюверов
+	team := suite.RandomTeam(4, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pr1 := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем первый пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pr1.PullRequestId,
+		PullRequestName: pr1.PullRequestName,
+		AuthorId:        pr1.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	require.Len(t, addPullRequest.JSON201.Pr.AssignedReviewers, 2)
+
+	// Находим члена команды без ревью - он должен попасть во второй пул реквест
+	busyMembers := make(map[string]struct{})
+	busyMembers[pr1.AuthorId] = struct{}{}
+	busyMembers[addPullRequest.JSON201.Pr.AssignedReviewers[0]] = struct{}{}
+	busyMembers[addPullRequest.JSON201.Pr.AssignedReviewers[1]] = struct{}{}
+
+	var freeUser string
+	for _, member := range team.Members {
+		if _, ok := busyMembers[member.UserId]; !ok {
+			freeUser = member.UserId
+			break
+		}
+	}
+
+	pr2 := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем второй пул реквест
+	addPullRequest, err = s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pr2.PullRequestId,
+		PullRequestName: pr2.PullRequestName,
+		AuthorId:        pr2.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	assert.Contains(t, addPullRequest.JSON201.Pr.AssignedReviewers, freeUser)
+}
+
+func TestPullRequests_Create_Dublicate(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+
+	// Вставляем дубликат
+	addPullRequest, err = s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON409)
+	assert.Equal(t, api.PREXISTS, addPullRequest.JSON409.Error.Code)
+	assert.Equal(t, PR_EXISTS, addPullRequest.JSON409.Error.Message)
+}
+
+func TestPullRequests_Create_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	pullRequest := suite.RandomPullRequest(gofakeit.UUID())
+
+	// Добавляем пул реквест с несуществующим автором
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON404)
+	assert.Equal(t, api.NOTFOUND, addPullRequest.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, addPullRequest.JSON404.Error.Message)
+}
+
+func TestPullRequests_Merge_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+
+	// Мерджим наш пул реквест
+	pullRequest.Status = api.PullRequestStatusMERGED
+	mergedAt := time.Now().Truncate(1)
+	pullRequest.MergedAt = &mergedAt
+
+	// Мерджим пул реквест в сервисе
+	mergedPullRequest, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, mergedPullRequest.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, mergedPullRequest.JSON200.Pr)
+
+	// Проверяем идемпотентность
+	mergedPullRequest, err = s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, mergedPullRequest.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, mergedPullRequest.JSON200.Pr)
+}
+
+func TestPullRequests_Merge_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Мерджим пул реквест
+	mergedPullRequest, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, mergedPullRequest.JSON404)
+	assert.Equal(t, api.NOTFOUND, mergedPullRequest.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, mergedPullRequest.JSON404.Error.Message)
+}
+
+func TestPullRequests_ReassignReviewer_Success(t *testing.T) {
 	s, ctx := suite.New(t)
 
 	// Создаем команду из 5 активных человек, чтобы все учавствовали в пул реквесте (5 неактивный)
@@ -564,3 +1198,1501 @@ func TestPullRequests_ReassignReviewer_NoCandidate(t *testing.T) {
 	assert.Equal(t, api.NOCANDIDATE, reassign.JSON409.Error.Code)
 	assert.Equal(t, NO_CANDIDATE, reassign.JSON409.Error.Message)
 }
+
+func TestPullRequests_Review_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Создаем команду из 3 активных человек, чтобы все учавствовали в пул реквесте
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeamResp.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+
+	// Первый ревьювер одобряет, второй запрашивает изменения
+	reviewer1 := addPullRequest.JSON201.Pr.AssignedReviewers[0]
+	reviewer2 := addPullRequest.JSON201.Pr.AssignedReviewers[1]
+
+	review, err := s.Client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		ReviewerId:    reviewer1,
+		Verdict:       api.PostPullRequestReviewJSONBodyVerdictAPPROVED,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON200)
+
+	review, err = s.Client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		ReviewerId:    reviewer2,
+		Verdict:       api.PostPullRequestReviewJSONBodyVerdictCHANGESREQUESTED,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, &review.JSON200.Pr)
+
+	require.NotNil(t, review.JSON200.Pr.Reviews)
+	assert.ElementsMatch(t, []api.Review{
+		{ReviewerId: reviewer1, Verdict: api.ReviewVerdictAPPROVED},
+		{ReviewerId: reviewer2, Verdict: api.ReviewVerdictCHANGESREQUESTED},
+	}, *review.JSON200.Pr.Reviews)
+}
+
+func TestPullRequests_Review_InvalidVerdict(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Оставляем ревью с неизвестным вердиктом
+	review, err := s.Client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: gofakeit.UUID(),
+		ReviewerId:    gofakeit.UUID(),
+		Verdict:       api.PostPullRequestReviewJSONBodyVerdict(gofakeit.Word()),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, review.JSON400.Error.Code)
+	assert.Equal(t, INVALID_VERDICT, review.JSON400.Error.Message)
+}
+
+func TestPullRequests_Review_NotAssigned(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeamResp.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Автор не может оставить ревью на свой пул реквест
+	review, err := s.Client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		ReviewerId:    pullRequest.AuthorId,
+		Verdict:       api.PostPullRequestReviewJSONBodyVerdictAPPROVED,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON409)
+	assert.Equal(t, api.NOTASSIGNED, review.JSON409.Error.Code)
+	assert.Equal(t, NOT_ASSIGNED, review.JSON409.Error.Message)
+}
+
+func TestPullRequests_Review_Merged(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeamResp.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Мерджим пул реквест
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	// Оставляем ревью после мерджа
+	review, err := s.Client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		ReviewerId:    addPullRequest.JSON201.Pr.AssignedReviewers[0],
+		Verdict:       api.PostPullRequestReviewJSONBodyVerdictAPPROVED,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON409)
+	assert.Equal(t, api.PRMERGED, review.JSON409.Error.Code)
+	assert.Equal(t, PR_MERGED, review.JSON409.Error.Message)
+}
+
+func TestPullRequests_Draft_MarkReady(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	pullRequest.Status = api.PullRequestStatusDRAFT
+	draft := true
+
+	// Добавляем черновик - ревьюверы не назначаются
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+		Draft:           &draft,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	suite.CheckPullRequestEqual(t, pullRequest, addPullRequest.JSON201.Pr)
+	assert.Empty(t, addPullRequest.JSON201.Pr.AssignedReviewers)
+
+	// Черновик нельзя смерджить
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON409)
+	assert.Equal(t, api.PRDRAFT, merge.JSON409.Error.Code)
+	assert.Equal(t, PR_DRAFT, merge.JSON409.Error.Message)
+
+	// Переводим в OPEN - назначаются ревьюверы
+	pullRequest.Status = api.PullRequestStatusOPEN
+	markReady, err := s.Client.PostPullRequestMarkReadyWithResponse(ctx, api.PostPullRequestMarkReadyJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, markReady.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, &markReady.JSON200.Pr)
+	assert.ElementsMatch(t,
+		[]string{team.Members[1].UserId, team.Members[2].UserId},
+		markReady.JSON200.Pr.AssignedReviewers,
+	)
+}
+
+func TestPullRequests_CloseReopen_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Закрываем пул реквест
+	pullRequest.Status = api.PullRequestStatusCLOSED
+	closePR, err := s.Client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, closePR.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, &closePR.JSON200.Pr)
+
+	// Закрытый пул реквест нельзя смерджить и нельзя переназначить
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON409)
+	assert.Equal(t, api.PRCLOSED, merge.JSON409.Error.Code)
+	assert.Equal(t, PR_CLOSED, merge.JSON409.Error.Message)
+
+	reassign, err := s.Client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		OldUserId:     addPullRequest.JSON201.Pr.AssignedReviewers[0],
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON409)
+	assert.Equal(t, api.PRCLOSED, reassign.JSON409.Error.Code)
+
+	// Переоткрываем пул реквест
+	pullRequest.Status = api.PullRequestStatusOPEN
+	reopen, err := s.Client.PostPullRequestReopenWithResponse(ctx, api.PostPullRequestReopenJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reopen.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, &reopen.JSON200.Pr)
+	assert.ElementsMatch(t,
+		addPullRequest.JSON201.Pr.AssignedReviewers,
+		reopen.JSON200.Pr.AssignedReviewers,
+	)
+}
+
+func TestPullRequests_Close_Merged(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Мерджим пул реквест
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	// Смердженный пул реквест нельзя закрыть
+	closePR, err := s.Client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, closePR.JSON409)
+	assert.Equal(t, api.PRMERGED, closePR.JSON409.Error.Code)
+	assert.Equal(t, PR_MERGED, closePR.JSON409.Error.Message)
+}
+
+func TestPullRequests_MarkReady_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Переводим в OPEN несуществующий пул реквест
+	markReady, err := s.Client.PostPullRequestMarkReadyWithResponse(ctx, api.PostPullRequestMarkReadyJSONRequestBody{
+		PullRequestId: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, markReady.JSON404)
+	assert.Equal(t, api.NOTFOUND, markReady.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, markReady.JSON404.Error.Message)
+}
+
+func TestPullRequests_List_Pagination(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	// Создаем 3 пул реквеста одного автора
+	pullRequests := make(map[string]*api.PullRequest)
+	for range 3 {
+		pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+		pullRequests[pullRequest.PullRequestId] = pullRequest
+
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequest.PullRequestId,
+			PullRequestName: pullRequest.PullRequestName,
+			AuthorId:        pullRequest.AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+	}
+
+	// Получаем первую страницу
+	limit := 2
+	firstPage, err := s.Client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+		AuthorId: &team.Members[0].UserId,
+		Limit:    &limit,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, firstPage.JSON200)
+	require.Len(t, firstPage.JSON200.PullRequests, 2)
+	require.NotNil(t, firstPage.JSON200.NextCursor)
+
+	// Получаем вторую, последнюю страницу
+	secondPage, err := s.Client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+		AuthorId: &team.Members[0].UserId,
+		Limit:    &limit,
+		Cursor:   firstPage.JSON200.NextCursor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, secondPage.JSON200)
+	require.Len(t, secondPage.JSON200.PullRequests, 1)
+	assert.Nil(t, secondPage.JSON200.NextCursor)
+
+	// Каждый пул реквест встречается ровно один раз
+	listed := append(firstPage.JSON200.PullRequests, secondPage.JSON200.PullRequests...)
+	for _, pr := range listed {
+		pullRequest, ok := pullRequests[pr.PullRequestId]
+		require.True(t, ok)
+		suite.CheckPullRequestEqual(t, pullRequest, &pr)
+		assert.Len(t, pr.AssignedReviewers, 2)
+		delete(pullRequests, pr.PullRequestId)
+	}
+	assert.Empty(t, pullRequests)
+}
+
+func TestPullRequests_List_Filters(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	// Создаем два пул реквеста и мерджим второй
+	pr1 := suite.RandomPullRequest(team.Members[0].UserId)
+	pr2 := suite.RandomPullRequest(team.Members[0].UserId)
+	for _, pullRequest := range []*api.PullRequest{pr1, pr2} {
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequest.PullRequestId,
+			PullRequestName: pullRequest.PullRequestName,
+			AuthorId:        pullRequest.AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+	}
+
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pr2.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	// Фильтруем по команде, ревьюверу и статусу
+	status := api.OPEN
+	list, err := s.Client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+		TeamName:   &team.TeamName,
+		ReviewerId: &team.Members[1].UserId,
+		Status:     &status,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, list.JSON200)
+	require.Len(t, list.JSON200.PullRequests, 1)
+	suite.CheckPullRequestEqual(t, pr1, &list.JSON200.PullRequests[0])
+	assert.Nil(t, list.JSON200.NextCursor)
+}
+
+func TestPullRequests_List_InvalidCursor(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Получаем страницу с неверным курсором
+	cursor := gofakeit.Word()
+	list, err := s.Client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+		Cursor: &cursor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, list.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, list.JSON400.Error.Code)
+	assert.Equal(t, INVALID_CURSOR, list.JSON400.Error.Message)
+}
+
+func TestPullRequests_List_CursorSortMismatch(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(2, func() bool { return true })
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	for range 2 {
+		pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequest.PullRequestId,
+			PullRequestName: pullRequest.PullRequestName,
+			AuthorId:        pullRequest.AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+	}
+
+	limit := 1
+	firstPage, err := s.Client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+		AuthorId: &team.Members[0].UserId,
+		Limit:    &limit,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, firstPage.JSON200)
+	require.NotNil(t, firstPage.JSON200.NextCursor)
+
+	// Курсор нельзя применить к другому полю или направлению сортировки
+	sortBy, order := api.MergedAt, api.Asc
+	for _, params := range []api.GetPullRequestListParams{
+		{SortBy: &sortBy},
+		{Order: &order},
+	} {
+		params.AuthorId = &team.Members[0].UserId
+		params.Limit = &limit
+		params.Cursor = firstPage.JSON200.NextCursor
+
+		list, err := s.Client.GetPullRequestListWithResponse(ctx, &params)
+		require.NoError(t, err)
+		require.NotEmpty(t, list.JSON400)
+		assert.Equal(t, api.INVALIDARGUMENT, list.JSON400.Error.Code)
+		assert.Equal(t, INVALID_CURSOR, list.JSON400.Error.Message)
+	}
+}
+
+func TestPullRequests_Get_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+	suite.CheckTeamsEqual(t, team, addTeam.JSON201.Team)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+
+	// Добавляем пул реквест
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Получаем пул реквест
+	getPullRequest, err := s.Client.GetPullRequestGetWithResponse(ctx, &api.GetPullRequestGetParams{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getPullRequest.JSON200)
+	suite.CheckPullRequestEqual(t, pullRequest, getPullRequest.JSON200)
+	assert.ElementsMatch(t,
+		addPullRequest.JSON201.Pr.AssignedReviewers,
+		getPullRequest.JSON200.AssignedReviewers,
+	)
+}
+
+func TestPullRequests_Get_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Получаем несуществующий пул реквест
+	getPullRequest, err := s.Client.GetPullRequestGetWithResponse(ctx, &api.GetPullRequestGetParams{
+		PullRequestId: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getPullRequest.JSON404)
+	assert.Equal(t, api.NOTFOUND, getPullRequest.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, getPullRequest.JSON404.Error.Message)
+}
+
+func TestPullRequests_History_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Команда из 5 активных человек, чтобы было кем заменить ревьювера
+	team := suite.RandomTeam(5, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	require.Len(t, addPullRequest.JSON201.Pr.AssignedReviewers, 2)
+	oldReviewer := addPullRequest.JSON201.Pr.AssignedReviewers[0]
+
+	// Переназначаем первого ревьювера
+	reassign, err := s.Client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: pullRequest.PullRequestId,
+		OldUserId:     oldReviewer,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON200)
+
+	// Снятый ревьювер остаётся в истории
+	history, err := s.Client.GetPullRequestHistoryWithResponse(ctx, &api.GetPullRequestHistoryParams{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, history.JSON200)
+	assert.Equal(t, pullRequest.PullRequestId, history.JSON200.PullRequestId)
+	require.Len(t, history.JSON200.Assignments, 3)
+
+	assignments := make(map[string]api.ReviewerAssignment)
+	for _, assignment := range history.JSON200.Assignments {
+		assignments[assignment.ReviewerId] = assignment
+	}
+
+	unassigned := assignments[oldReviewer]
+	assert.Equal(t, api.ReviewerAssignmentReasonInitial, unassigned.Reason)
+	require.NotNil(t, unassigned.UnassignedAt)
+
+	replacement := assignments[reassign.JSON200.ReplacedBy]
+	assert.Equal(t, api.ReviewerAssignmentReasonManualReassign, replacement.Reason)
+	assert.Nil(t, replacement.UnassignedAt)
+	assert.Equal(t, *unassigned.UnassignedAt, replacement.AssignedAt)
+
+	current := assignments[addPullRequest.JSON201.Pr.AssignedReviewers[1]]
+	assert.Equal(t, api.ReviewerAssignmentReasonInitial, current.Reason)
+	assert.Nil(t, current.UnassignedAt)
+}
+
+func TestPullRequests_History_Deactivation(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(5, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	oldReviewer := addPullRequest.JSON201.Pr.AssignedReviewers[0]
+
+	// Деактивируем ревьювера и переназначаем команду
+	setIsActive, err := s.Client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+		UserId:   oldReviewer,
+		IsActive: false,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setIsActive.JSON200)
+
+	reassign, err := s.Client.PostTeamReassignWithResponse(ctx, api.PostTeamReassignJSONRequestBody{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON200)
+	require.Len(t, reassign.JSON200.Reassignments, 1)
+
+	history, err := s.Client.GetPullRequestHistoryWithResponse(ctx, &api.GetPullRequestHistoryParams{
+		PullRequestId: pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, history.JSON200)
+	require.Len(t, history.JSON200.Assignments, 3)
+
+	reassignment := reassign.JSON200.Reassignments[0]
+	assert.Equal(t, pullRequest.PullRequestId, reassignment.PullRequestId)
+	assert.Equal(t, api.OutcomeREASSIGNED, reassignment.Outcome)
+	require.NotNil(t, reassignment.NewReviewer)
+
+	last := history.JSON200.Assignments[2]
+	assert.Equal(t, *reassignment.NewReviewer, last.ReviewerId)
+	assert.Equal(t, api.ReviewerAssignmentReasonDeactivation, last.Reason)
+}
+
+func TestTeam_Reassign_Outcomes(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Единственного ревьювера заменить некем
+	team := suite.RandomTeam(2, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	author, reviewer := team.Members[0].UserId, team.Members[1].UserId
+
+	pullRequests := []*api.PullRequest{
+		suite.RandomPullRequest(author),
+		suite.RandomPullRequest(author),
+	}
+	for _, pullRequest := range pullRequests {
+		addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   pullRequest.PullRequestId,
+			PullRequestName: pullRequest.PullRequestName,
+			AuthorId:        pullRequest.AuthorId,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, addPullRequest.JSON201)
+		require.Equal(t, []string{reviewer}, addPullRequest.JSON201.Pr.AssignedReviewers)
+	}
+
+	merge, err := s.Client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequests[1].PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, merge.JSON200)
+
+	setIsActive, err := s.Client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+		UserId:   reviewer,
+		IsActive: false,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setIsActive.JSON200)
+
+	reassign, err := s.Client.PostTeamReassignWithResponse(ctx, api.PostTeamReassignJSONRequestBody{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON200)
+
+	outcomes := make(map[string]api.ReassignmentOutcome)
+	for _, reassignment := range reassign.JSON200.Reassignments {
+		assert.Equal(t, reviewer, reassignment.OldReviewer)
+		assert.Nil(t, reassignment.NewReviewer)
+		outcomes[reassignment.PullRequestId] = reassignment.Outcome
+	}
+	assert.Equal(t, map[string]api.ReassignmentOutcome{
+		pullRequests[0].PullRequestId: api.OutcomeNOCANDIDATE,
+		pullRequests[1].PullRequestId: api.OutcomePRMERGED,
+	}, outcomes)
+	assert.Equal(t, []string{pullRequests[0].PullRequestId}, reassign.JSON200.UnresolvedPullRequests)
+}
+
+func TestTeam_DryRun(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Единственного ревьювера заменить некем
+	team := suite.RandomTeam(2, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	author, reviewer := team.Members[0].UserId, team.Members[1].UserId
+
+	pullRequest := suite.RandomPullRequest(author)
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+	require.Equal(t, []string{reviewer}, addPullRequest.JSON201.Pr.AssignedReviewers)
+
+	// Пробная деактивация показывает, что ревьювера не заменить
+	dryRun := true
+	deactivate, err := s.Client.PostTeamDeactivateWithResponse(ctx, api.PostTeamDeactivateJSONRequestBody{
+		TeamName: team.TeamName,
+		DryRun:   &dryRun,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, deactivate.JSON200)
+	for _, member := range deactivate.JSON200.Members {
+		assert.False(t, member.IsActive)
+	}
+	require.NotNil(t, deactivate.JSON200.Reassignments)
+	require.Len(t, *deactivate.JSON200.Reassignments, 1)
+	assert.Equal(t, api.OutcomeNOCANDIDATE, (*deactivate.JSON200.Reassignments)[0].Outcome)
+	require.NotNil(t, deactivate.JSON200.UnresolvedPullRequests)
+	assert.Equal(t, []string{pullRequest.PullRequestId}, *deactivate.JSON200.UnresolvedPullRequests)
+
+	// Команда осталась активной
+	getTeam, err := s.Client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getTeam.JSON200)
+	suite.CheckTeamsEqual(t, team, getTeam.JSON200)
+
+	// Пробное переназначение ничего не меняет
+	setIsActive, err := s.Client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+		UserId:   reviewer,
+		IsActive: false,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setIsActive.JSON200)
+
+	reassign, err := s.Client.PostTeamReassignWithResponse(ctx, api.PostTeamReassignJSONRequestBody{
+		TeamName: team.TeamName,
+		DryRun:   &dryRun,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON200)
+	assert.Equal(t, []string{pullRequest.PullRequestId}, reassign.JSON200.UnresolvedPullRequests)
+
+	review, err := s.Client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{
+		UserId: reviewer,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, review.JSON200)
+	require.Len(t, review.JSON200.PullRequests, 1)
+	assert.Equal(t, pullRequest.PullRequestId, review.JSON200.PullRequests[0].PullRequestId)
+}
+
+func TestPullRequests_BulkCreate_JSON(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(3, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	author, reviewer := team.Members[0].UserId, team.Members[1].UserId
+
+	existing := suite.RandomPullRequest(author)
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   existing.PullRequestId,
+		PullRequestName: existing.PullRequestName,
+		AuthorId:        existing.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Ревьюверы сохраняются такими, какими их передали
+	keepReviewers := true
+	reviewers := []string{reviewer}
+	created := suite.RandomPullRequest(author)
+	bulkCreate, err := s.Client.PostPullRequestBulkCreateWithResponse(ctx, &api.PostPullRequestBulkCreateParams{
+		KeepReviewers: &keepReviewers,
+	}, api.PostPullRequestBulkCreateJSONRequestBody{
+		{
+			PullRequestId:     created.PullRequestId,
+			PullRequestName:   created.PullRequestName,
+			AuthorId:          author,
+			AssignedReviewers: &reviewers,
+		},
+		{
+			PullRequestId:   existing.PullRequestId,
+			PullRequestName: existing.PullRequestName,
+			AuthorId:        author,
+		},
+		{
+			PullRequestId:   gofakeit.UUID(),
+			PullRequestName: gofakeit.Sentence(3),
+			AuthorId:        gofakeit.UUID(),
+		},
+		{
+			PullRequestId:     gofakeit.UUID(),
+			PullRequestName:   gofakeit.Sentence(3),
+			AuthorId:          author,
+			AssignedReviewers: &[]string{author},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, bulkCreate.JSON200)
+
+	results := bulkCreate.JSON200.Results
+	require.Len(t, results, 4)
+	assert.Equal(t, api.BulkCREATED, results[0].Status)
+	require.NotNil(t, results[0].Pr)
+	assert.Equal(t, reviewers, results[0].Pr.AssignedReviewers)
+	assert.Equal(t, api.BulkCONFLICT, results[1].Status)
+	require.NotNil(t, results[1].Error)
+	assert.Equal(t, string(api.PREXISTS), results[1].Error.Code)
+	assert.Equal(t, api.BulkNOTFOUND, results[2].Status)
+	assert.Equal(t, api.BulkINVALID, results[3].Status)
+
+	getPullRequest, err := s.Client.GetPullRequestGetWithResponse(ctx, &api.GetPullRequestGetParams{
+		PullRequestId: created.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getPullRequest.JSON200)
+	assert.Equal(t, reviewers, getPullRequest.JSON200.AssignedReviewers)
+}
+
+func TestPullRequests_BulkCreate_Stream(t *testing.T) {
+	s, _ := suite.New(t)
+
+	// Пачка из BULK_CREATE_BATCH_SIZE PR создаётся дольше общего тайм-аута
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	team := suite.RandomTeam(2, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+	author := team.Members[0].UserId
+
+	// На один PR больше пачки, а затем некорректная строка
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	pullRequestIDs := make([]string, 101)
+	for i := range pullRequestIDs {
+		pullRequestIDs[i] = gofakeit.UUID()
+		require.NoError(t, encoder.Encode(api.BulkPullRequest{
+			PullRequestId:   pullRequestIDs[i],
+			PullRequestName: gofakeit.Sentence(3),
+			AuthorId:        author,
+		}))
+	}
+	body.WriteString("{not json\n")
+
+	// Сгенерированный клиент разбирает ответ как один JSON, поэтому поток читается вручную
+	bulkCreate, err := s.Client.ClientInterface.PostPullRequestBulkCreateWithBody(
+		ctx, &api.PostPullRequestBulkCreateParams{}, "application/x-ndjson", &body,
+	)
+	require.NoError(t, err)
+	defer bulkCreate.Body.Close()
+	require.Equal(t, http.StatusOK, bulkCreate.StatusCode)
+	assert.Equal(t, "application/x-ndjson", bulkCreate.Header.Get("Content-Type"))
+
+	decoder := json.NewDecoder(bulkCreate.Body)
+	for _, pullRequestID := range pullRequestIDs {
+		var result api.BulkCreateResult
+		require.NoError(t, decoder.Decode(&result))
+		assert.Equal(t, pullRequestID, result.PullRequestId)
+		assert.Equal(t, api.BulkCREATED, result.Status)
+	}
+
+	var result api.BulkCreateResult
+	require.NoError(t, decoder.Decode(&result))
+	assert.Equal(t, api.BulkINVALID, result.Status)
+	assert.Empty(t, result.PullRequestId)
+	assert.False(t, decoder.More())
+}
+
+func TestPullRequests_History_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	history, err := s.Client.GetPullRequestHistoryWithResponse(ctx, &api.GetPullRequestHistoryParams{
+		PullRequestId: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, history.JSON404)
+	assert.Equal(t, api.NOTFOUND, history.JSON404.Error.Code)
+}
+
+// Тесты подписок на события
+
+func TestWebhooks_CRUD_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	url := gofakeit.URL()
+	events := []api.WebhookEvent{api.ReviewerAssigned, api.ReviewerReassigned}
+
+	// Создаём подписку
+	addWebhook, err := s.Client.PostWebhooksWithResponse(ctx, api.PostWebhooksJSONRequestBody{
+		Url:    url,
+		Secret: gofakeit.Password(true, true, true, false, false, 16),
+		Events: &events,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addWebhook.JSON201)
+	require.NotEmpty(t, addWebhook.JSON201.Webhook)
+	webhook := addWebhook.JSON201.Webhook
+	assert.Equal(t, url, webhook.Url)
+	assert.Equal(t, events, webhook.Events)
+	assert.True(t, webhook.IsActive)
+
+	// Подписка есть в списке
+	listWebhooks, err := s.Client.GetWebhooksWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, listWebhooks.JSON200)
+	found := false
+	for _, listed := range listWebhooks.JSON200.Webhooks {
+		if listed.WebhookId == webhook.WebhookId {
+			found = true
+			assert.Equal(t, url, listed.Url)
+			assert.Equal(t, events, listed.Events)
+		}
+	}
+	assert.True(t, found)
+
+	// Отключаем подписку
+	isActive := false
+	patchWebhook, err := s.Client.PatchWebhooksWithResponse(ctx, api.PatchWebhooksJSONRequestBody{
+		WebhookId: webhook.WebhookId,
+		IsActive:  &isActive,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, patchWebhook.JSON200)
+	require.NotEmpty(t, patchWebhook.JSON200.Webhook)
+	assert.False(t, patchWebhook.JSON200.Webhook.IsActive)
+	assert.Equal(t, url, patchWebhook.JSON200.Webhook.Url)
+
+	// Удаляем подписку
+	deleteWebhook, err := s.Client.DeleteWebhooksWithResponse(ctx, &api.DeleteWebhooksParams{
+		WebhookId: webhook.WebhookId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 204, deleteWebhook.StatusCode())
+
+	// Повторно удалить нельзя
+	deleteWebhook, err = s.Client.DeleteWebhooksWithResponse(ctx, &api.DeleteWebhooksParams{
+		WebhookId: webhook.WebhookId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, deleteWebhook.JSON404)
+	assert.Equal(t, api.NOTFOUND, deleteWebhook.JSON404.Error.Code)
+}
+
+func TestWebhooks_Add_Invalid(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Некорректный адрес
+	addWebhook, err := s.Client.PostWebhooksWithResponse(ctx, api.PostWebhooksJSONRequestBody{
+		Url:    gofakeit.Word(),
+		Secret: gofakeit.Word(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addWebhook.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, addWebhook.JSON400.Error.Code)
+	assert.Equal(t, INVALID_WEBHOOK_URL, addWebhook.JSON400.Error.Message)
+
+	// Неизвестное событие
+	events := []api.WebhookEvent{api.WebhookEvent(gofakeit.Word())}
+	addWebhook, err = s.Client.PostWebhooksWithResponse(ctx, api.PostWebhooksJSONRequestBody{
+		Url:    gofakeit.URL(),
+		Secret: gofakeit.Word(),
+		Events: &events,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addWebhook.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, addWebhook.JSON400.Error.Code)
+	assert.Equal(t, UNKNOWN_WEBHOOK_EVENT, addWebhook.JSON400.Error.Message)
+}
+
+func TestWebhooks_Update_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	isActive := false
+	patchWebhook, err := s.Client.PatchWebhooksWithResponse(ctx, api.PatchWebhooksJSONRequestBody{
+		WebhookId: -gofakeit.Int64(),
+		IsActive:  &isActive,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, patchWebhook.JSON404)
+	assert.Equal(t, api.NOTFOUND, patchWebhook.JSON404.Error.Code)
+	assert.Equal(t, NOT_FOUND, patchWebhook.JSON404.Error.Message)
+}
+
+// Тесты интеграций
+
+// Событие pull_request GitHub
+func githubPullRequestEvent(action, repo string, number int, login string, merged bool) []byte {
+	return fmt.Appendf(nil, `{
+		"action": %q,
+		"number": %d,
+		"pull_request": {
+			"number": %d,
+			"title": "Integration PR",
+			"draft": false,
+			"merged": %t,
+			"user": {"login": %q}
+		},
+		"repository": {"full_name": %q}
+	}`, action, number, number, merged, login, repo)
+}
+
+func githubSign(secret string, body []byte) *string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return &signature
+}
+
+func TestIntegrations_GitHub_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, func() bool { return true })
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	// Сопоставляем логин автору
+	login := gofakeit.Username()
+	setLogin, err := s.Client.PostIntegrationsLoginsWithResponse(ctx, api.PostIntegrationsLoginsJSONRequestBody{
+		Provider: api.Github,
+		Login:    login,
+		UserId:   team.Members[0].UserId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setLogin.JSON200)
+
+	repo := gofakeit.Username() + "/" + gofakeit.Word()
+	number := gofakeit.Number(1, 100000)
+	secret := s.Cfg.Integrations.GitHubSecret
+
+	// PR открыт
+	body := githubPullRequestEvent("opened", repo, number, login, false)
+	opened, err := s.Client.PostIntegrationsGithubWebhookWithBodyWithResponse(ctx,
+		&api.PostIntegrationsGithubWebhookParams{
+			XGitHubEvent:     "pull_request",
+			XHubSignature256: githubSign(secret, body),
+		},
+		"application/json", bytes.NewReader(body),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, opened.JSON200)
+	assert.Equal(t, api.Opened, opened.JSON200.Action)
+	require.NotEmpty(t, opened.JSON200.Pr)
+	assert.Equal(t, fmt.Sprintf("%s#%d", repo, number), opened.JSON200.Pr.PullRequestId)
+	assert.Equal(t, team.Members[0].UserId, opened.JSON200.Pr.AuthorId)
+	assert.Equal(t, api.PullRequestStatusOPEN, opened.JSON200.Pr.Status)
+
+	// PR влит
+	body = githubPullRequestEvent("closed", repo, number, login, true)
+	merged, err := s.Client.PostIntegrationsGithubWebhookWithBodyWithResponse(ctx,
+		&api.PostIntegrationsGithubWebhookParams{
+			XGitHubEvent:     "pull_request",
+			XHubSignature256: githubSign(secret, body),
+		},
+		"application/json", bytes.NewReader(body),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, merged.JSON200)
+	assert.Equal(t, api.Merged, merged.JSON200.Action)
+	require.NotEmpty(t, merged.JSON200.Pr)
+	assert.Equal(t, api.PullRequestStatusMERGED, merged.JSON200.Pr.Status)
+}
+
+func TestIntegrations_GitHub_InvalidSignature(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	body := githubPullRequestEvent("opened", "org/repo", 1, gofakeit.Username(), false)
+	resp, err := s.Client.PostIntegrationsGithubWebhookWithBodyWithResponse(ctx,
+		&api.PostIntegrationsGithubWebhookParams{
+			XGitHubEvent:     "pull_request",
+			XHubSignature256: githubSign("wrong-secret", body),
+		},
+		"application/json", bytes.NewReader(body),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON401)
+	assert.Equal(t, api.INVALIDSIGNATURE, resp.JSON401.Error.Code)
+	assert.Equal(t, INVALID_SIGNATURE, resp.JSON401.Error.Message)
+}
+
+func TestIntegrations_GitHub_UnknownLogin(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	body := githubPullRequestEvent("opened", "org/repo", 1, gofakeit.Username(), false)
+	resp, err := s.Client.PostIntegrationsGithubWebhookWithBodyWithResponse(ctx,
+		&api.PostIntegrationsGithubWebhookParams{
+			XGitHubEvent:     "pull_request",
+			XHubSignature256: githubSign(s.Cfg.Integrations.GitHubSecret, body),
+		},
+		"application/json", bytes.NewReader(body),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON404)
+	assert.Equal(t, api.NOTFOUND, resp.JSON404.Error.Code)
+	assert.Equal(t, UNKNOWN_VCS_LOGIN, resp.JSON404.Error.Message)
+}
+
+func TestIntegrations_Logins_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+
+	// Добавить команду
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	login := api.VCSLogin{
+		Provider: api.Gitlab,
+		Login:    gofakeit.Username(),
+		UserId:   team.Members[0].UserId,
+	}
+
+	// Сопоставляем логин и переназначаем на другого пользователя
+	setLogin, err := s.Client.PostIntegrationsLoginsWithResponse(ctx, login)
+	require.NoError(t, err)
+	require.NotEmpty(t, setLogin.JSON200)
+
+	login.UserId = team.Members[1].UserId
+	setLogin, err = s.Client.PostIntegrationsLoginsWithResponse(ctx, login)
+	require.NoError(t, err)
+	require.NotEmpty(t, setLogin.JSON200)
+	assert.Equal(t, &login, setLogin.JSON200.Login)
+
+	// Соответствие есть в списке
+	listLogins, err := s.Client.GetIntegrationsLoginsWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, listLogins.JSON200)
+	assert.Contains(t, listLogins.JSON200.Logins, login)
+
+	// Удаляем соответствие
+	deleteLogin, err := s.Client.DeleteIntegrationsLoginsWithResponse(ctx, &api.DeleteIntegrationsLoginsParams{
+		Provider: login.Provider,
+		Login:    login.Login,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 204, deleteLogin.StatusCode())
+
+	// Повторно удалить нельзя
+	deleteLogin, err = s.Client.DeleteIntegrationsLoginsWithResponse(ctx, &api.DeleteIntegrationsLoginsParams{
+		Provider: login.Provider,
+		Login:    login.Login,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, deleteLogin.JSON404)
+	assert.Equal(t, api.NOTFOUND, deleteLogin.JSON404.Error.Code)
+}
+
+func TestIntegrations_Logins_NotFound(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	setLogin, err := s.Client.PostIntegrationsLoginsWithResponse(ctx, api.VCSLogin{
+		Provider: api.Github,
+		Login:    gofakeit.Username(),
+		UserId:   gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, setLogin.JSON404)
+	assert.Equal(t, api.NOTFOUND, setLogin.JSON404.Error.Code)
+}
+
+// Тесты авторизации
+
+func createToken(
+	t *testing.T,
+	s *suite.Suite,
+	ctx context.Context,
+	role api.TokenRole,
+	teamName *string,
+) *api.ClientWithResponses {
+	t.Helper()
+
+	resp, err := s.Client.PostAuthTokensWithResponse(ctx, api.PostAuthTokensJSONRequestBody{
+		Name:     gofakeit.Username(),
+		Role:     role,
+		TeamName: teamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON201)
+	assert.Equal(t, role, resp.JSON201.Token.Role)
+	require.NotEmpty(t, resp.JSON201.Value)
+
+	return s.NewClient(t, resp.JSON201.Value)
+}
+
+func TestAuth_Unauthorized(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+
+	// Без токена
+	resp, err := s.NewClient(t, "").PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	assert.Equal(t, 401, resp.StatusCode())
+
+	// С несуществующим токеном
+	resp, err = s.NewClient(t, gofakeit.UUID()).PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	assert.Equal(t, 401, resp.StatusCode())
+
+	// Команда не создана
+	getTeam, err := s.Client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getTeam.JSON404)
+}
+
+func TestAuth_ReadOnly(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	client := createToken(t, s, ctx, api.ReadOnly, nil)
+
+	// Чтение разрешено
+	getTeam, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, getTeam.JSON200)
+
+	// Изменение запрещено
+	deactivate, err := client.PostTeamDeactivateWithResponse(ctx, api.PostTeamDeactivateJSONRequestBody{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 403, deactivate.StatusCode())
+
+	// Управление токенами доступно только администратору
+	tokens, err := client.GetAuthTokensWithResponse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 403, tokens.StatusCode())
+}
+
+func TestAuth_TeamMaintainer(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	addTeam, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	otherTeam := suite.RandomTeam(membersCount, gofakeit.Bool)
+	addTeam, err = s.Client.PostTeamAddWithResponse(ctx, *otherTeam)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeam.JSON201)
+
+	client := createToken(t, s, ctx, api.TeamMaintainer, &team.TeamName)
+
+	// Чужой командой управлять нельзя
+	deactivate, err := client.PostTeamDeactivateWithResponse(ctx, api.PostTeamDeactivateJSONRequestBody{
+		TeamName: otherTeam.TeamName,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 403, deactivate.StatusCode())
+
+	// PR автора из чужой команды создать нельзя
+	createPR, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   gofakeit.UUID(),
+		PullRequestName: gofakeit.Sentence(3),
+		AuthorId:        otherTeam.Members[0].UserId,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 403, createPR.StatusCode())
+
+	// Своей командой управлять можно
+	createPR, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   gofakeit.UUID(),
+		PullRequestName: gofakeit.Sentence(3),
+		AuthorId:        team.Members[0].UserId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, createPR.JSON201)
+
+	deactivate, err = client.PostTeamDeactivateWithResponse(ctx, api.PostTeamDeactivateJSONRequestBody{
+		TeamName: team.TeamName,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, deactivate.JSON200)
+}
+
+func TestAuth_Tokens_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Выпускаем токен
+	addToken, err := s.Client.PostAuthTokensWithResponse(ctx, api.PostAuthTokensJSONRequestBody{
+		Name: gofakeit.Username(),
+		Role: api.Bot,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addToken.JSON201)
+	token := addToken.JSON201.Token
+	client := s.NewClient(t, addToken.JSON201.Value)
+
+	// Токен есть в списке
+	listTokens, err := s.Client.GetAuthTokensWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, listTokens.JSON200)
+	found := false
+	for _, listed := range listTokens.JSON200.Tokens {
+		if listed.TokenId == token.TokenId {
+			found = true
+			assert.Equal(t, token.Name, listed.Name)
+			assert.Nil(t, listed.RevokedAt)
+		}
+	}
+	assert.True(t, found)
+
+	// Токен работает
+	listPRs, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{})
+	require.NoError(t, err)
+	require.NotEmpty(t, listPRs.JSON200)
+
+	// Отзываем токен дважды
+	for range 2 {
+		revoke, err := s.Client.DeleteAuthTokensWithResponse(ctx, &api.DeleteAuthTokensParams{
+			TokenId: token.TokenId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 204, revoke.StatusCode())
+	}
+
+	// Отозванный токен не работает
+	listPRs, err = client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 401, listPRs.StatusCode())
+}
+
+func TestAuth_Tokens_Invalid(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	addToken, err := s.Client.PostAuthTokensWithResponse(ctx, api.PostAuthTokensJSONRequestBody{
+		Name: gofakeit.Username(),
+		Role: api.TokenRole(gofakeit.Word()),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addToken.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, addToken.JSON400.Error.Code)
+	assert.Equal(t, UNKNOWN_TOKEN_ROLE, addToken.JSON400.Error.Message)
+
+	revoke, err := s.Client.DeleteAuthTokensWithResponse(ctx, &api.DeleteAuthTokensParams{
+		TokenId: -1,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, revoke.JSON404)
+	assert.Equal(t, api.NOTFOUND, revoke.JSON404.Error.Code)
+}
+
+// Тесты журнала изменений
+
+func TestAudit_PullRequest_Success(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	team := suite.RandomTeam(5, func() bool { return true })
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+
+	pullRequest := suite.RandomPullRequest(team.Members[0].UserId)
+	addPullRequest, err := s.Client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequest.PullRequestId,
+		PullRequestName: pullRequest.PullRequestName,
+		AuthorId:        pullRequest.AuthorId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, addPullRequest.JSON201)
+
+	// Переназначаем ревьювера с собственным ID запроса
+	requestID := gofakeit.UUID()
+	oldReviewer := addPullRequest.JSON201.Pr.AssignedReviewers[0]
+	reassign, err := s.Client.PostPullRequestReassignWithResponse(
+		ctx,
+		api.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: pullRequest.PullRequestId,
+			OldUserId:     oldReviewer,
+		},
+		func(_ context.Context, req *http.Request) error {
+			req.Header.Set("X-Request-ID", requestID)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, reassign.JSON200)
+	assert.Equal(t, requestID, reassign.HTTPResponse.Header.Get("X-Request-ID"))
+
+	// События возвращаются от новых к старым
+	audit, err := s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		PullRequestId: &pullRequest.PullRequestId,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, audit.JSON200)
+	require.Len(t, audit.JSON200.Events, 2)
+	assert.Nil(t, audit.JSON200.NextCursor)
+
+	reassignEvent := audit.JSON200.Events[0]
+	assert.Equal(t, "pull_request.reassign", reassignEvent.Operation)
+	assert.Equal(t, "token:config", reassignEvent.Actor)
+	assert.Equal(t, requestID, reassignEvent.RequestId)
+	require.NotNil(t, reassignEvent.UserId)
+	assert.Equal(t, oldReviewer, *reassignEvent.UserId)
+	require.NotNil(t, reassignEvent.Before)
+	require.NotNil(t, reassignEvent.After)
+	assert.Contains(t, (*reassignEvent.Before)["assigned_reviewers"], oldReviewer)
+	assert.NotContains(t, (*reassignEvent.After)["assigned_reviewers"], oldReviewer)
+	assert.Contains(t, (*reassignEvent.After)["assigned_reviewers"], reassign.JSON200.ReplacedBy)
+
+	createEvent := audit.JSON200.Events[1]
+	assert.Equal(t, "pull_request.create", createEvent.Operation)
+	assert.Nil(t, createEvent.Before)
+	require.NotNil(t, createEvent.After)
+	assert.NotEqual(t, requestID, createEvent.RequestId)
+
+	// Постраничная навигация
+	limit := 1
+	page, err := s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		PullRequestId: &pullRequest.PullRequestId,
+		Limit:         &limit,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.JSON200)
+	require.Len(t, page.JSON200.Events, 1)
+	require.NotNil(t, page.JSON200.NextCursor)
+
+	page, err = s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		PullRequestId: &pullRequest.PullRequestId,
+		Limit:         &limit,
+		Cursor:        page.JSON200.NextCursor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.JSON200)
+	require.Len(t, page.JSON200.Events, 1)
+	assert.Equal(t, createEvent.EventId, page.JSON200.Events[0].EventId)
+}
+
+func TestAudit_Team_TimeRange(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	from := time.Now().Add(-time.Minute)
+
+	team := suite.RandomTeam(membersCount, gofakeit.Bool)
+	addTeamResp, err := s.Client.PostTeamAddWithResponse(ctx, *team)
+	require.NoError(t, err)
+	require.NotEmpty(t, addTeamResp.JSON201)
+
+	// Событие попадает в диапазон
+	to := time.Now().Add(time.Minute)
+	audit, err := s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		TeamName: &team.TeamName,
+		From:     &from,
+		To:       &to,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, audit.JSON200)
+	require.Len(t, audit.JSON200.Events, 1)
+	assert.Equal(t, "team.add", audit.JSON200.Events[0].Operation)
+
+	// И не попадает в прошедший
+	audit, err = s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		TeamName: &team.TeamName,
+		To:       &from,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, audit.JSON200)
+	assert.Empty(t, audit.JSON200.Events)
+}
+
+func TestAudit_Invalid(t *testing.T) {
+	s, ctx := suite.New(t)
+
+	// Конец диапазона раньше начала
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	audit, err := s.Client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+		From: &from,
+		To:   &to,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, audit.JSON400)
+	assert.Equal(t, api.INVALIDARGUMENT, audit.JSON400.Error.Code)
+
+	// Журнал доступен только администратору
+	client := createToken(t, s, ctx, api.ReadOnly, nil)
+	forbidden, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 403, forbidden.StatusCode())
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/iskanye/avito-tech-internship/internal/repositories"
+	"github.com/iskanye/avito-tech-internship/internal/repositories/repotest"
+	"github.com/iskanye/avito-tech-internship/tests/suite"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// Трейсер, считающий выполненные запросы
+type queryCounter struct {
+	queries atomic.Int64
+}
+
+func (c *queryCounter) TraceQueryStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	_ pgx.TraceQueryStartData,
+) context.Context {
+	c.queries.Add(1)
+	return ctx
+}
+
+func (c *queryCounter) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+func TestStorage_QueryCounts(t *testing.T) {
+	cfg := suite.LoadConfig()
+
+	if cfg.Storage.Driver != "postgres" {
+		t.Skip("query counts are checked against PostgreSQL")
+	}
+
+	counter := &queryCounter{}
+	storage, err := repositories.New(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.DBName,
+		cfg.Postgres.MaxConns,
+		trmpgx.DefaultCtxGetter,
+		repositories.RandomStrategy{},
+		counter,
+	)
+	require.NoError(t, err)
+	t.Cleanup(storage.Stop)
+
+	txManager := manager.Must(trmpgx.NewDefaultFactory(storage.GetPool()))
+
+	repotest.CheckQueryCounts(t, txManager, storage, counter.queries.Load, nil)
+}
@@ -13,6 +13,7 @@ import (
 )
 
 type Suite struct {
+	Cfg    *config.Config
 	Client *api.ClientWithResponses
 }
 
@@ -20,8 +21,7 @@ func New(t *testing.T) (*Suite, context.Context) {
 	t.Helper()
 	t.Parallel()
 
-	cfg := config.MustLoadPath(configPath())
-	cfg.LoadEnv()
+	cfg := LoadConfig()
 
 	сtx, cancel := context.WithTimeout(
 		context.Background(),
@@ -33,16 +33,41 @@ func New(t *testing.T) (*Suite, context.Context) {
 		cancel()
 	})
 
+	s := &Suite{
+		Cfg: cfg,
+	}
+	// По умолчанию запросы выполняются с токеном администратора
+	s.Client = s.NewClient(t, cfg.Auth.AdminToken)
+
+	return s, сtx
+}
+
+// Создаёт клиента, отправляющего запросы с данным токеном (пустой - без токена)
+func (s *Suite) NewClient(t *testing.T, token string) *api.ClientWithResponses {
+	t.Helper()
+
 	hc := http.Client{}
 	c, err := api.NewClientWithResponses(
-		fmt.Sprintf("http://%s:%d/", cfg.Host, cfg.Port),
+		fmt.Sprintf("http://%s:%d/", s.Cfg.Host, s.Cfg.Port),
 		api.WithHTTPClient(&hc),
+		api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			return nil
+		}),
 	)
 	require.NoError(t, err)
 
-	return &Suite{
-		Client: c,
-	}, сtx
+	return c
+}
+
+// Загружает конфиг тестируемого сервиса
+func LoadConfig() *config.Config {
+	cfg := config.MustLoadPath(configPath())
+	cfg.LoadEnv()
+
+	return cfg
 }
 
 func configPath() string {